@@ -0,0 +1,2 @@
+# DHCP servers are imported by interface
+terraform import pfsense_dhcp_server.lan lan
@@ -0,0 +1,6 @@
+# Static mappings are imported by <interface>/<mac>, "/" and "%" in either part are escaped as %2F and %25
+terraform import pfsense_dhcp_static_mapping.printer lan/aa:bb:cc:dd:ee:ff
+
+# or by <interface>/host_name=<host_name> or <interface>/ip_address=<ip_address>
+terraform import pfsense_dhcp_static_mapping.printer lan/host_name=printer
+terraform import pfsense_dhcp_static_mapping.printer lan/ip_address=192.168.1.20
@@ -0,0 +1,2 @@
+# Aliases are imported by name
+terraform import pfsense_firewall_alias.web_servers web_servers
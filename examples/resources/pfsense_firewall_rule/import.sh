@@ -0,0 +1,5 @@
+# Rules are imported by tracker ID
+terraform import pfsense_firewall_rule.https 1690000000
+
+# or by description=<description>, which must match exactly one rule
+terraform import pfsense_firewall_rule.https "description=Allow HTTPS"
@@ -0,0 +1,2 @@
+# Rule orders are imported by interface
+terraform import pfsense_firewall_rule_order.lan lan
@@ -0,0 +1,2 @@
+# Schedules are imported by name
+terraform import pfsense_firewall_schedule.work_hours work_hours
@@ -0,0 +1,5 @@
+# Interfaces are imported by pfSense interface ID
+terraform import pfsense_interface.lan lan
+
+# or by description=<description>
+terraform import pfsense_interface.lan description=LAN
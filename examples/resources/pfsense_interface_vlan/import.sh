@@ -0,0 +1,5 @@
+# VLANs are imported by VLAN interface
+terraform import pfsense_interface_vlan.guest igb1.20
+
+# or by description=<description>
+terraform import pfsense_interface_vlan.guest description=Guest
@@ -0,0 +1,5 @@
+# 1:1 mappings are imported by their position in the list of 1:1 mappings, which changes when mappings before them are removed
+terraform import pfsense_nat_one_to_one.example 0
+
+# or by description=<description>, which must match exactly one mapping
+terraform import pfsense_nat_one_to_one.example "description=Web server"
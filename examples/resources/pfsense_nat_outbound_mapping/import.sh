@@ -0,0 +1,5 @@
+# Outbound mappings are imported by their position in the list of outbound mappings, which changes when mappings before them are removed
+terraform import pfsense_nat_outbound_mapping.example 0
+
+# or by description=<description>, which must match exactly one mapping
+terraform import pfsense_nat_outbound_mapping.example "description=Guest network"
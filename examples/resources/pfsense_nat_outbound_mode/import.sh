@@ -0,0 +1,2 @@
+# The outbound NAT mode is a singleton imported by the ID outbound
+terraform import pfsense_nat_outbound_mode.mode outbound
@@ -0,0 +1,5 @@
+# Port forwards are imported by their position in the list of port forwards, which changes when port forwards before them are removed
+terraform import pfsense_nat_port_forward.example 0
+
+# or by description=<description>, which must match exactly one port forward
+terraform import pfsense_nat_port_forward.example "description=Web server"
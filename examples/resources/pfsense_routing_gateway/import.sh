@@ -0,0 +1,2 @@
+# Gateways are imported by name
+terraform import pfsense_routing_gateway.wan WAN_DHCP
@@ -0,0 +1,2 @@
+# Gateway groups are imported by name
+terraform import pfsense_routing_gateway_group.failover failover
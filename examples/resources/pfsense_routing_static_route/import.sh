@@ -0,0 +1,5 @@
+# Static routes are imported by network
+terraform import pfsense_routing_static_route.office 10.20.0.0/16
+
+# or by description=<description>
+terraform import pfsense_routing_static_route.office "description=Office network"
@@ -0,0 +1,2 @@
+# Limiters are imported by name
+terraform import pfsense_traffic_shaper_limiter.download download
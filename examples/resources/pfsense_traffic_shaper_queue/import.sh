@@ -0,0 +1,2 @@
+# Queues are imported by <interface>/<name>, "/" and "%" in either part are escaped as %2F and %25
+terraform import pfsense_traffic_shaper_queue.voip wan/qVoIP
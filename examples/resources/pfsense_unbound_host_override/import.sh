@@ -0,0 +1,2 @@
+# Host overrides are imported by host name
+terraform import pfsense_unbound_host_override.nas nas.home.arpa
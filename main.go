@@ -1,12 +1,70 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
 	"github.com/elacy/terraform-pfsense-provider/pfsense"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := generate(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: pfsense.Provider,
 	})
 }
+
+// generate writes import blocks and resources for everything on pfSense, e.g.
+//
+//	PFSENSE_PROFILE=home terraform-provider-pfsense generate -out imported.tf
+func generate(args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	outPath := flags.String("out", "", "File to write to, default is stdout")
+	resources := flags.String("resources", "", "Comma separated resources to generate, e.g. pfsense_firewall_rule,pfsense_firewall_alias. Default is every resource")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s generate [-out file] [-resources names]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Writes import blocks and resources for the items on pfSense. The provider is configured with the PFSENSE_* environment variables or the credentials file.")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var resourceNames []string
+
+	if *resources != "" {
+		resourceNames = strings.Split(*resources, ",")
+	}
+
+	var out io.Writer = os.Stdout
+
+	if *outPath != "" {
+		file, err := os.Create(*outPath)
+
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+		out = file
+	}
+
+	return pfsense.Generate(context.Background(), out, os.Stderr, resourceNames)
+}
@@ -0,0 +1,319 @@
+package pfsense
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// errNotFound is returned when an item can't be found in pfSense.
+var errNotFound = errors.New("Unable to find item")
+
+// apiStatusError is returned by apiRequest when pfSense responds with a status code other than 2xx.
+type apiStatusError struct {
+	statusCode int
+	message    string
+}
+
+func (e *apiStatusError) Error() string {
+	if e.message == "" {
+		return fmt.Sprintf("non 2xx response code received: %d", e.statusCode)
+	}
+
+	return fmt.Sprintf("%s, response code %d", e.message, e.statusCode)
+}
+
+// hasStatusCode checks whether err is a response with the status code.
+func hasStatusCode(err error, statusCode int) bool {
+	var statusErr *apiStatusError
+
+	return errors.As(err, &statusErr) && statusErr.statusCode == statusCode
+}
+
+// apiResponse is the envelope the pfSense API wraps around every response.
+type apiResponse[DataType any] struct {
+	Status  string   `json:"status"`
+	Code    int      `json:"code"`
+	Return  int      `json:"return"`
+	Message string   `json:"message"`
+	Data    DataType `json:"data"`
+}
+
+// apiRequest calls endpoints that pfsenseapi.Client doesn't expose, using the client's configuration for host,
+// authentication and TLS settings.
+func apiRequest[DataType any](ctx context.Context, client *apiClient, method string, endpoint string, query map[string]string, body interface{}) (DataType, error) {
+	var zeroValue DataType
+	var requestBody []byte
+
+	if body != nil {
+		var err error
+
+		if requestBody, err = json.Marshal(body); err != nil {
+			return zeroValue, err
+		}
+	}
+
+	res, token, err := apiDo(ctx, client, method, endpoint, query, requestBody, "")
+
+	if err != nil {
+		return zeroValue, err
+	}
+
+	// refresh token and try again if expired
+	if client.Cfg.JWTAuthEnabled && res.StatusCode == http.StatusUnauthorized {
+		res.Body.Close()
+
+		if res, _, err = apiDo(ctx, client, method, endpoint, query, requestBody, token); err != nil {
+			return zeroValue, err
+		}
+	}
+
+	defer res.Body.Close()
+
+	responseBody, err := io.ReadAll(res.Body)
+
+	if err != nil {
+		return zeroValue, err
+	}
+
+	response := new(apiResponse[DataType])
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		// the message is left out when the body isn't a pfSense API response
+		json.Unmarshal(responseBody, response)
+
+		return zeroValue, &apiStatusError{statusCode: res.StatusCode, message: response.Message}
+	}
+
+	if err = json.Unmarshal(responseBody, response); err != nil {
+		return zeroValue, err
+	}
+
+	return response.Data, nil
+}
+
+// apiDo sends a request and returns the JWT it was authenticated with, rejectedToken is a JWT that has expired and has
+// to be replaced.
+func apiDo(ctx context.Context, client *apiClient, method string, endpoint string, query map[string]string, body []byte, rejectedToken string) (*http.Response, string, error) {
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", client.Cfg.Host, endpoint), bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	q := req.URL.Query()
+
+	for key, value := range query {
+		q.Add(key, value)
+	}
+
+	req.URL.RawQuery = q.Encode()
+	req.Header.Add("Accept", "application/json")
+
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	var token string
+
+	switch {
+	case client.Cfg.JWTAuthEnabled:
+		if token, err = client.accessToken(ctx, rejectedToken); err != nil {
+			return nil, "", err
+		}
+
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	case client.Cfg.LocalAuthEnabled:
+		req.SetBasicAuth(client.Cfg.User, client.Cfg.Password)
+	case client.Cfg.TokenAuthEnabled:
+		req.Header.Add("Authorization", fmt.Sprintf("%s %s", client.Cfg.ApiClientID, client.Cfg.ApiClientToken))
+	}
+
+	res, err := client.httpClient.Do(req)
+
+	return res, token, err
+}
+
+// accessToken returns the JWT apiRequest authenticates with, a new one is created when there's none yet or the
+// current one is rejectedToken. Requests run concurrently so only the first to find the token rejected replaces it.
+func (c *apiClient) accessToken(ctx context.Context, rejectedToken string) (string, error) {
+	c.tokenLock.Lock()
+	defer c.tokenLock.Unlock()
+
+	if c.jwtToken == "" || c.jwtToken == rejectedToken {
+		token, err := c.Token.CreateAccessToken(ctx)
+
+		if err != nil {
+			return "", err
+		}
+
+		c.jwtToken = token
+	}
+
+	return c.jwtToken, nil
+}
+
+// indexedItem is implemented by responses that pfSense identifies by their position in a list.
+type indexedItem[ResponseType any] interface {
+	*ResponseType
+	setId(string)
+}
+
+// apiWriteRequest merges extra fields such as apply and id into a request.
+func apiWriteRequest(request interface{}, fields map[string]interface{}) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	data, err := json.Marshal(request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	for key, value := range fields {
+		result[key] = value
+	}
+
+	return result, nil
+}
+
+func apiListIndexed[ResponseType any, PointerType indexedItem[ResponseType]](ctx context.Context, client *apiClient, endpoint string) ([]*ResponseType, error) {
+	list, err := apiRequest[[]*ResponseType](ctx, client, http.MethodGet, endpoint, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, item := range list {
+		PointerType(item).setId(strconv.Itoa(i))
+	}
+
+	return list, nil
+}
+
+// apiCreateIndexed creates an item and finds it by comparing the list before and after creating it, pfSense doesn't
+// return the position of new items. Writes to the list have to be serialized for the comparison to find the right item.
+func apiCreateIndexed[ResponseType any, PointerType indexedItem[ResponseType]](ctx context.Context, client *apiClient, endpoint string, request interface{}, apply bool) (*ResponseType, error) {
+	body, err := apiWriteRequest(request, map[string]interface{}{"apply": apply})
+
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := apiRequest[[]json.RawMessage](ctx, client, http.MethodGet, endpoint, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := apiRequest[interface{}](ctx, client, http.MethodPost, endpoint, nil, body); err != nil {
+		return nil, err
+	}
+
+	after, err := apiRequest[[]json.RawMessage](ctx, client, http.MethodGet, endpoint, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	index := newIndexedItem(before, after)
+
+	if index < 0 {
+		return nil, fmt.Errorf("Unable to find the item created at %s", endpoint)
+	}
+
+	item := new(ResponseType)
+
+	if err := json.Unmarshal(after[index], item); err != nil {
+		return nil, err
+	}
+
+	PointerType(item).setId(strconv.Itoa(index))
+
+	return item, nil
+}
+
+// newIndexedItem returns the position of the first item in after that isn't in before, or -1 if there's none.
+func newIndexedItem(before []json.RawMessage, after []json.RawMessage) int {
+	existing := map[string]int{}
+
+	for _, item := range before {
+		existing[string(item)]++
+	}
+
+	for i, item := range after {
+		if existing[string(item)] == 0 {
+			return i
+		}
+
+		existing[string(item)]--
+	}
+
+	return -1
+}
+
+func apiUpdateIndexed[ResponseType any, PointerType indexedItem[ResponseType]](ctx context.Context, client *apiClient, endpoint string, id string, request interface{}, apply bool) (*ResponseType, error) {
+	index, err := strconv.Atoi(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := apiWriteRequest(request, map[string]interface{}{"apply": apply, "id": index})
+
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := apiRequest[*ResponseType](ctx, client, http.MethodPut, endpoint, nil, body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil {
+		return nil, fmt.Errorf("No item was returned when updating %s at %s", id, endpoint)
+	}
+
+	PointerType(response).setId(id)
+
+	return response, nil
+}
+
+func apiDeleteIndexed(ctx context.Context, client *apiClient, endpoint string, id string, apply bool) error {
+	_, err := apiRequest[interface{}](ctx, client, http.MethodDelete, endpoint, map[string]string{
+		"id":    id,
+		"apply": strconv.FormatBool(apply),
+	}, nil)
+
+	return err
+}
+
+// apiStringList unmarshals config values which are a single string when there is one item and an array otherwise.
+type apiStringList []string
+
+func (l *apiStringList) UnmarshalJSON(data []byte) error {
+	var list []string
+
+	if err := json.Unmarshal(data, &list); err == nil {
+		*l = list
+		return nil
+	}
+
+	var item string
+
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+
+	*l = splitIntoArray(item, ",")
+
+	return nil
+}
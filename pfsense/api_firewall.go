@@ -0,0 +1,217 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+const (
+	firewallScheduleEndpoint = "api/v1/firewall/schedule"
+	firewallRuleEndpoint     = "api/v1/firewall/rule"
+)
+
+// firewallSchedule represents a single schedule, pfSense identifies schedules by their position in the list.
+type firewallSchedule struct {
+	Index     int                          `json:"-"`
+	Name      string                       `json:"name"`
+	Descr     string                       `json:"descr"`
+	TimeRange []*firewallScheduleTimeRange `json:"timerange"`
+}
+
+func (s *firewallSchedule) setId(id string) {
+	s.Index, _ = strconv.Atoi(id)
+}
+
+// firewallScheduleTimeRange is either a set of dates, stored as parallel lists of months and days, or a set of
+// weekdays stored as positions where Monday is 1.
+type firewallScheduleTimeRange struct {
+	Month      string `json:"month,omitempty"`
+	Day        string `json:"day,omitempty"`
+	Position   string `json:"position,omitempty"`
+	Hour       string `json:"hour"`
+	RangeDescr string `json:"rangedescr,omitempty"`
+}
+
+type firewallScheduleRequest struct {
+	Descr     string                       `json:"descr,omitempty"`
+	Name      string                       `json:"name"`
+	TimeRange []*firewallScheduleTimeRange `json:"timerange"`
+}
+
+func listFirewallSchedules(ctx context.Context, client *apiClient) ([]*firewallSchedule, error) {
+	return apiListIndexed[firewallSchedule](ctx, client, firewallScheduleEndpoint)
+}
+
+func getFirewallSchedule(ctx context.Context, client *apiClient, name string) (*firewallSchedule, error) {
+	list, err := listFirewallSchedules(ctx, client)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, schedule := range list {
+		if schedule.Name == name {
+			return schedule, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Unable to find schedule %s", name)
+}
+
+func createFirewallSchedule(ctx context.Context, client *apiClient, request firewallScheduleRequest, apply bool) (*firewallSchedule, error) {
+	return apiCreateIndexed[firewallSchedule](ctx, client, firewallScheduleEndpoint, request, apply)
+}
+
+func updateFirewallSchedule(ctx context.Context, client *apiClient, name string, request firewallScheduleRequest, apply bool) (*firewallSchedule, error) {
+	schedule, err := getFirewallSchedule(ctx, client, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return apiUpdateIndexed[firewallSchedule](ctx, client, firewallScheduleEndpoint, strconv.Itoa(schedule.Index), request, apply)
+}
+
+func deleteFirewallSchedule(ctx context.Context, client *apiClient, name string, apply bool) error {
+	schedule, err := getFirewallSchedule(ctx, client, name)
+
+	if err != nil {
+		return err
+	}
+
+	return apiDeleteIndexed(ctx, client, firewallScheduleEndpoint, strconv.Itoa(schedule.Index), apply)
+}
+
+// getFirewallRule fetches a single rule by its tracker, the API filters lists by any of the fields in the query.
+func getFirewallRule(ctx context.Context, client *apiClient, tracker int) (*pfsenseapi.FirewallRule, error) {
+	rules, err := apiRequest[[]*pfsenseapi.FirewallRule](ctx, client, http.MethodGet, firewallRuleEndpoint, map[string]string{
+		"tracker": strconv.Itoa(tracker),
+	}, nil)
+
+	// the rule has been deleted
+	if hasStatusCode(err, http.StatusNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		if int(rule.Tracker) == tracker {
+			return rule, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// firewallRuleOrder is the order of the rules on a single interface, floating rules are evaluated separately and
+// aren't included.
+type firewallRuleOrder struct {
+	Interface string
+	Rules     []int
+}
+
+type firewallRuleOrderRequest struct {
+	Interface string
+	Rules     []int
+}
+
+func listFirewallRuleOrders(ctx context.Context, client *apiClient) ([]*firewallRuleOrder, error) {
+	rules, err := client.Firewall.ListRules(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	orders := []*firewallRuleOrder{}
+	orderByInterface := map[string]*firewallRuleOrder{}
+
+	for _, rule := range rules {
+		if rule.Floating == "yes" {
+			continue
+		}
+
+		order, ok := orderByInterface[rule.Interface]
+
+		if !ok {
+			order = &firewallRuleOrder{Interface: rule.Interface, Rules: []int{}}
+			orderByInterface[rule.Interface] = order
+			orders = append(orders, order)
+		}
+
+		order.Rules = append(order.Rules, int(rule.Tracker))
+	}
+
+	return orders, nil
+}
+
+func getFirewallRuleOrder(ctx context.Context, client *apiClient, iface string) (*firewallRuleOrder, error) {
+	orders, err := listFirewallRuleOrders(ctx, client)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, order := range orders {
+		if order.Interface == iface {
+			return order, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Unable to find any rules on interface %s", iface)
+}
+
+// updateFirewallRuleOrder moves the rules to the top of the interface one at a time starting from the last one, which
+// leaves them in the requested order. Every rule on the interface has to be requested, a rule left out would end up
+// below the requested ones and be reported as moved.
+func updateFirewallRuleOrder(ctx context.Context, client *apiClient, request firewallRuleOrderRequest, apply bool) (*firewallRuleOrder, error) {
+	current, err := getFirewallRuleOrder(ctx, client, request.Interface)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, tracker := range request.Rules {
+		if !slices.Contains(current.Rules, tracker) {
+			return nil, fmt.Errorf("Unable to find rule %d on interface %s", tracker, request.Interface)
+		}
+
+		if slices.Contains(request.Rules[:i], tracker) {
+			return nil, fmt.Errorf("Unable to order the rules on interface %s, rule %d is listed more than once", request.Interface, tracker)
+		}
+	}
+
+	var missing []string
+
+	for _, tracker := range current.Rules {
+		if !slices.Contains(request.Rules, tracker) {
+			missing = append(missing, strconv.Itoa(tracker))
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("Unable to order the rules on interface %s, rules %s are on the interface but not in rules. Every rule on the interface has to be listed", request.Interface, strings.Join(missing, ", "))
+	}
+
+	for i := len(request.Rules) - 1; i >= 0; i-- {
+		body := map[string]interface{}{
+			"tracker": request.Rules[i],
+			"top":     true,
+			"apply":   apply && i == 0,
+		}
+
+		if _, err := apiRequest[interface{}](ctx, client, http.MethodPut, firewallRuleEndpoint, nil, body); err != nil {
+			return nil, err
+		}
+	}
+
+	return getFirewallRuleOrder(ctx, client, request.Interface)
+}
@@ -0,0 +1,192 @@
+package pfsense
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+const (
+	natPortForwardEndpoint     = "api/v1/firewall/nat/port_forward"
+	natOutboundEndpoint        = "api/v1/firewall/nat/outbound"
+	natOutboundMappingEndpoint = "api/v1/firewall/nat/outbound/mapping"
+	natOneToOneEndpoint        = "api/v1/firewall/nat/one_to_one"
+	natOutboundModeId          = "outbound"
+)
+
+// natPortForward represents a single NAT port forward, pfSense identifies port forwards by their position in the list.
+type natPortForward struct {
+	Id               string                     `json:"-"`
+	Interface        string                     `json:"interface"`
+	Protocol         string                     `json:"protocol"`
+	IPProtocol       string                     `json:"ipprotocol"`
+	Source           *pfsenseapi.FirewallTarget `json:"source,omitempty"`
+	Destination      *pfsenseapi.FirewallTarget `json:"destination,omitempty"`
+	Target           string                     `json:"target"`
+	LocalPort        string                     `json:"local-port"`
+	AssociatedRuleId string                     `json:"associated-rule-id"`
+	NATReflection    string                     `json:"natreflection"`
+	Disabled         pfsenseapi.TrueIfPresent   `json:"disabled"`
+	Descr            string                     `json:"descr"`
+}
+
+func (n *natPortForward) setId(id string) {
+	n.Id = id
+}
+
+type natPortForwardRequest struct {
+	Descr                 string `json:"descr,omitempty"`
+	Disabled              bool   `json:"disabled"`
+	Dst                   string `json:"dst"`
+	DstPort               string `json:"dstport,omitempty"`
+	FilterRuleAssociation string `json:"filter-rule-association,omitempty"`
+	Interface             string `json:"interface"`
+	LocalPort             string `json:"local-port,omitempty"`
+	NATReflection         string `json:"natreflection,omitempty"`
+	Protocol              string `json:"protocol"`
+	Src                   string `json:"src"`
+	SrcPort               string `json:"srcport,omitempty"`
+	Target                string `json:"target"`
+}
+
+func listNATPortForwards(ctx context.Context, client *apiClient) ([]*natPortForward, error) {
+	return apiListIndexed[natPortForward](ctx, client, natPortForwardEndpoint)
+}
+
+func createNATPortForward(ctx context.Context, client *apiClient, request natPortForwardRequest, apply bool) (*natPortForward, error) {
+	return apiCreateIndexed[natPortForward](ctx, client, natPortForwardEndpoint, request, apply)
+}
+
+func updateNATPortForward(ctx context.Context, client *apiClient, id string, request natPortForwardRequest, apply bool) (*natPortForward, error) {
+	return apiUpdateIndexed[natPortForward](ctx, client, natPortForwardEndpoint, id, request, apply)
+}
+
+func deleteNATPortForward(ctx context.Context, client *apiClient, id string, apply bool) error {
+	return apiDeleteIndexed(ctx, client, natPortForwardEndpoint, id, apply)
+}
+
+// natOutboundMapping represents a single outbound NAT mapping, pfSense identifies mappings by their position in the list.
+type natOutboundMapping struct {
+	Id            string                     `json:"-"`
+	Interface     string                     `json:"interface"`
+	Protocol      string                     `json:"protocol"`
+	Source        *pfsenseapi.FirewallTarget `json:"source,omitempty"`
+	Destination   *pfsenseapi.FirewallTarget `json:"destination,omitempty"`
+	Target        string                     `json:"target"`
+	PoolOpts      string                     `json:"poolopts"`
+	NATPort       string                     `json:"natport"`
+	StaticNATPort pfsenseapi.TrueIfPresent   `json:"staticnatport"`
+	NoNAT         pfsenseapi.TrueIfPresent   `json:"nonat"`
+	Disabled      pfsenseapi.TrueIfPresent   `json:"disabled"`
+	Descr         string                     `json:"descr"`
+}
+
+func (n *natOutboundMapping) setId(id string) {
+	n.Id = id
+}
+
+type natOutboundMappingRequest struct {
+	Descr         string `json:"descr,omitempty"`
+	Disabled      bool   `json:"disabled"`
+	Dst           string `json:"dst"`
+	DstPort       string `json:"dstport,omitempty"`
+	Interface     string `json:"interface"`
+	NATPort       string `json:"natport,omitempty"`
+	NoNAT         bool   `json:"nonat"`
+	PoolOpts      string `json:"poolopts,omitempty"`
+	Protocol      string `json:"protocol"`
+	Src           string `json:"src"`
+	SrcPort       string `json:"srcport,omitempty"`
+	StaticNATPort bool   `json:"staticnatport"`
+	Target        string `json:"target,omitempty"`
+}
+
+func listNATOutboundMappings(ctx context.Context, client *apiClient) ([]*natOutboundMapping, error) {
+	return apiListIndexed[natOutboundMapping](ctx, client, natOutboundMappingEndpoint)
+}
+
+func createNATOutboundMapping(ctx context.Context, client *apiClient, request natOutboundMappingRequest, apply bool) (*natOutboundMapping, error) {
+	return apiCreateIndexed[natOutboundMapping](ctx, client, natOutboundMappingEndpoint, request, apply)
+}
+
+func updateNATOutboundMapping(ctx context.Context, client *apiClient, id string, request natOutboundMappingRequest, apply bool) (*natOutboundMapping, error) {
+	return apiUpdateIndexed[natOutboundMapping](ctx, client, natOutboundMappingEndpoint, id, request, apply)
+}
+
+func deleteNATOutboundMapping(ctx context.Context, client *apiClient, id string, apply bool) error {
+	return apiDeleteIndexed(ctx, client, natOutboundMappingEndpoint, id, apply)
+}
+
+// natOutboundMode is the outbound NAT mode, there is only ever one per pfSense instance.
+type natOutboundMode struct {
+	Mode string `json:"mode"`
+}
+
+type natOutboundModeRequest struct {
+	Mode string `json:"mode"`
+}
+
+func getNATOutboundMode(ctx context.Context, client *apiClient) (*natOutboundMode, error) {
+	return apiRequest[*natOutboundMode](ctx, client, http.MethodGet, natOutboundEndpoint, nil, nil)
+}
+
+func updateNATOutboundMode(ctx context.Context, client *apiClient, request natOutboundModeRequest, apply bool) (*natOutboundMode, error) {
+	body, err := apiWriteRequest(request, map[string]interface{}{"apply": apply})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := apiRequest[interface{}](ctx, client, http.MethodPut, natOutboundEndpoint, nil, body); err != nil {
+		return nil, err
+	}
+
+	return getNATOutboundMode(ctx, client)
+}
+
+// natOneToOne represents a single 1:1 NAT mapping, pfSense identifies mappings by their position in the list.
+type natOneToOne struct {
+	Id            string                     `json:"-"`
+	Interface     string                     `json:"interface"`
+	IPProtocol    string                     `json:"ipprotocol"`
+	External      string                     `json:"external"`
+	Source        *pfsenseapi.FirewallTarget `json:"source,omitempty"`
+	Destination   *pfsenseapi.FirewallTarget `json:"destination,omitempty"`
+	NATReflection string                     `json:"natreflection"`
+	NoBINAT       pfsenseapi.TrueIfPresent   `json:"nobinat"`
+	Disabled      pfsenseapi.TrueIfPresent   `json:"disabled"`
+	Descr         string                     `json:"descr"`
+}
+
+func (n *natOneToOne) setId(id string) {
+	n.Id = id
+}
+
+type natOneToOneRequest struct {
+	Descr         string `json:"descr,omitempty"`
+	Disabled      bool   `json:"disabled"`
+	Dst           string `json:"dst"`
+	External      string `json:"external"`
+	Interface     string `json:"interface"`
+	IPProtocol    string `json:"ipprotocol,omitempty"`
+	NATReflection string `json:"natreflection,omitempty"`
+	NoBINAT       bool   `json:"nobinat"`
+	Src           string `json:"src"`
+}
+
+func listNATOneToOnes(ctx context.Context, client *apiClient) ([]*natOneToOne, error) {
+	return apiListIndexed[natOneToOne](ctx, client, natOneToOneEndpoint)
+}
+
+func createNATOneToOne(ctx context.Context, client *apiClient, request natOneToOneRequest, apply bool) (*natOneToOne, error) {
+	return apiCreateIndexed[natOneToOne](ctx, client, natOneToOneEndpoint, request, apply)
+}
+
+func updateNATOneToOne(ctx context.Context, client *apiClient, id string, request natOneToOneRequest, apply bool) (*natOneToOne, error) {
+	return apiUpdateIndexed[natOneToOne](ctx, client, natOneToOneEndpoint, id, request, apply)
+}
+
+func deleteNATOneToOne(ctx context.Context, client *apiClient, id string, apply bool) error {
+	return apiDeleteIndexed(ctx, client, natOneToOneEndpoint, id, apply)
+}
@@ -0,0 +1,370 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+const (
+	routingGatewayEndpoint        = "api/v1/routing/gateway"
+	routingDefaultGatewayEndpoint = "api/v1/routing/gateway/default"
+	routingGatewayGroupEndpoint   = "api/v1/routing/gateway/group"
+	routingStaticRouteEndpoint    = "api/v1/routing/static_route"
+	gatewayGroupItemSeparator     = "|"
+)
+
+// routingGateway represents a single gateway, pfSense identifies gateways by their position in the config which it
+// returns as the attribute, gateways that aren't stored in the config (e.g. DHCP) have an attribute of `system`.
+type routingGateway struct {
+	Name           string                     `json:"name"`
+	Interface      string                     `json:"interface"`
+	FriendlyIface  string                     `json:"friendlyiface"`
+	IPProtocol     string                     `json:"ipprotocol"`
+	Gateway        string                     `json:"gateway"`
+	Monitor        string                     `json:"monitor"`
+	MonitorDisable pfsenseapi.TrueIfPresent   `json:"monitor_disable"`
+	Weight         pfsenseapi.OptionalJSONInt `json:"weight"`
+	LatencyLow     pfsenseapi.OptionalJSONInt `json:"latencylow"`
+	LatencyHigh    pfsenseapi.OptionalJSONInt `json:"latencyhigh"`
+	LossLow        pfsenseapi.OptionalJSONInt `json:"losslow"`
+	LossHigh       pfsenseapi.OptionalJSONInt `json:"losshigh"`
+	IsDefaultGW    bool                       `json:"isdefaultgw"`
+	Disabled       pfsenseapi.TrueIfPresent   `json:"disabled"`
+	Dynamic        bool                       `json:"dynamic"`
+	Attribute      interface{}                `json:"attribute"`
+	Descr          string                     `json:"descr"`
+}
+
+type routingGatewayRequest struct {
+	Descr          string `json:"descr,omitempty"`
+	Disabled       bool   `json:"disabled"`
+	Gateway        string `json:"gateway"`
+	Interface      string `json:"interface"`
+	IPProtocol     string `json:"ipprotocol"`
+	LatencyHigh    int    `json:"latencyhigh,omitempty"`
+	LatencyLow     int    `json:"latencylow,omitempty"`
+	LossHigh       int    `json:"losshigh,omitempty"`
+	LossLow        int    `json:"losslow,omitempty"`
+	Monitor        string `json:"monitor,omitempty"`
+	MonitorDisable bool   `json:"monitor_disable"`
+	Name           string `json:"name"`
+	Weight         int    `json:"weight,omitempty"`
+
+	// The default gateway is set through a separate endpoint
+	DefaultGateway bool `json:"-"`
+}
+
+type routingDefaultGatewayRequest struct {
+	DefaultGW4 *string `json:"defaultgw4,omitempty"`
+	DefaultGW6 *string `json:"defaultgw6,omitempty"`
+	Apply      bool    `json:"apply"`
+}
+
+func listRoutingGateways(ctx context.Context, client *apiClient) ([]*routingGateway, error) {
+	gateways, err := apiRequest[map[string]*routingGateway](ctx, client, http.MethodGet, routingGatewayEndpoint, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*routingGateway, 0, len(gateways))
+
+	for _, gateway := range gateways {
+		list = append(list, gateway)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list, nil
+}
+
+func getRoutingGateway(ctx context.Context, client *apiClient, name string) (*routingGateway, error) {
+	list, err := listRoutingGateways(ctx, client)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, gateway := range list {
+		if gateway.Name == name {
+			return gateway, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Unable to find gateway %s", name)
+}
+
+func getRoutingGatewayIndex(ctx context.Context, client *apiClient, name string) (int, error) {
+	gateway, err := getRoutingGateway(ctx, client, name)
+
+	if err != nil {
+		return 0, err
+	}
+
+	switch attribute := gateway.Attribute.(type) {
+	case float64:
+		return int(attribute), nil
+	case string:
+		if index, err := strconv.Atoi(attribute); err == nil {
+			return index, nil
+		}
+	}
+
+	return 0, fmt.Errorf("Gateway %s isn't stored in the pfSense configuration and can't be modified", name)
+}
+
+func createRoutingGateway(ctx context.Context, client *apiClient, request routingGatewayRequest, apply bool) (*routingGateway, error) {
+	body, err := apiWriteRequest(request, map[string]interface{}{"apply": apply})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := apiRequest[interface{}](ctx, client, http.MethodPost, routingGatewayEndpoint, nil, body); err != nil {
+		return nil, err
+	}
+
+	if err := setRoutingDefaultGateway(ctx, client, request, apply); err != nil {
+		return nil, err
+	}
+
+	return getRoutingGateway(ctx, client, request.Name)
+}
+
+func updateRoutingGateway(ctx context.Context, client *apiClient, name string, request routingGatewayRequest, apply bool) (*routingGateway, error) {
+	index, err := getRoutingGatewayIndex(ctx, client, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := apiWriteRequest(request, map[string]interface{}{"apply": apply, "id": index})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := apiRequest[interface{}](ctx, client, http.MethodPut, routingGatewayEndpoint, nil, body); err != nil {
+		return nil, err
+	}
+
+	if err := setRoutingDefaultGateway(ctx, client, request, apply); err != nil {
+		return nil, err
+	}
+
+	return getRoutingGateway(ctx, client, request.Name)
+}
+
+func deleteRoutingGateway(ctx context.Context, client *apiClient, name string, apply bool) error {
+	index, err := getRoutingGatewayIndex(ctx, client, name)
+
+	if err != nil {
+		return err
+	}
+
+	return apiDeleteIndexed(ctx, client, routingGatewayEndpoint, strconv.Itoa(index), apply)
+}
+
+// setRoutingDefaultGateway makes the gateway the default for its IP protocol, or returns the default to automatic
+// selection when the gateway is currently the default but no longer should be.
+func setRoutingDefaultGateway(ctx context.Context, client *apiClient, request routingGatewayRequest, apply bool) error {
+	var defaultGateway string
+
+	if request.DefaultGateway {
+		defaultGateway = request.Name
+	} else {
+		gateway, err := getRoutingGateway(ctx, client, request.Name)
+
+		if err != nil {
+			return err
+		}
+
+		if !gateway.IsDefaultGW {
+			return nil
+		}
+	}
+
+	defaultRequest := routingDefaultGatewayRequest{Apply: apply}
+
+	if request.IPProtocol == "inet6" {
+		defaultRequest.DefaultGW6 = &defaultGateway
+	} else {
+		defaultRequest.DefaultGW4 = &defaultGateway
+	}
+
+	_, err := apiRequest[interface{}](ctx, client, http.MethodPut, routingDefaultGatewayEndpoint, nil, defaultRequest)
+
+	return err
+}
+
+// routingGatewayGroup represents a single gateway group, pfSense identifies groups by their position in the list.
+type routingGatewayGroup struct {
+	Index   int           `json:"-"`
+	Name    string        `json:"name"`
+	Trigger string        `json:"trigger"`
+	Item    apiStringList `json:"item"`
+	Descr   string        `json:"descr"`
+}
+
+func (g *routingGatewayGroup) setId(id string) {
+	g.Index, _ = strconv.Atoi(id)
+}
+
+type routingGatewayGroupMember struct {
+	Gateway   string
+	Tier      int
+	VirtualIP string
+}
+
+// members parses the items of a gateway group which are stored as `gateway|tier|virtual ip`.
+func (g *routingGatewayGroup) members() ([]*routingGatewayGroupMember, error) {
+	members := make([]*routingGatewayGroupMember, len(g.Item))
+
+	for i, item := range g.Item {
+		parts := strings.Split(item, gatewayGroupItemSeparator)
+
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("Unable to parse gateway group item %s", item)
+		}
+
+		tier, err := strconv.Atoi(parts[1])
+
+		if err != nil {
+			return nil, fmt.Errorf("Unable to parse tier of gateway group item %s: %v", item, err)
+		}
+
+		members[i] = &routingGatewayGroupMember{
+			Gateway: parts[0],
+			Tier:    tier,
+		}
+
+		if len(parts) > 2 {
+			members[i].VirtualIP = parts[2]
+		}
+	}
+
+	return members, nil
+}
+
+type routingGatewayGroupRequest struct {
+	Descr   string   `json:"descr,omitempty"`
+	Item    []string `json:"item"`
+	Name    string   `json:"name"`
+	Trigger string   `json:"trigger"`
+}
+
+func listRoutingGatewayGroups(ctx context.Context, client *apiClient) ([]*routingGatewayGroup, error) {
+	return apiListIndexed[routingGatewayGroup](ctx, client, routingGatewayGroupEndpoint)
+}
+
+func getRoutingGatewayGroup(ctx context.Context, client *apiClient, name string) (*routingGatewayGroup, error) {
+	list, err := listRoutingGatewayGroups(ctx, client)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range list {
+		if group.Name == name {
+			return group, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Unable to find gateway group %s", name)
+}
+
+func createRoutingGatewayGroup(ctx context.Context, client *apiClient, request routingGatewayGroupRequest, apply bool) (*routingGatewayGroup, error) {
+	return apiCreateIndexed[routingGatewayGroup](ctx, client, routingGatewayGroupEndpoint, request, apply)
+}
+
+func updateRoutingGatewayGroup(ctx context.Context, client *apiClient, name string, request routingGatewayGroupRequest, apply bool) (*routingGatewayGroup, error) {
+	group, err := getRoutingGatewayGroup(ctx, client, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return apiUpdateIndexed[routingGatewayGroup](ctx, client, routingGatewayGroupEndpoint, strconv.Itoa(group.Index), request, apply)
+}
+
+func deleteRoutingGatewayGroup(ctx context.Context, client *apiClient, name string, apply bool) error {
+	group, err := getRoutingGatewayGroup(ctx, client, name)
+
+	if err != nil {
+		return err
+	}
+
+	return apiDeleteIndexed(ctx, client, routingGatewayGroupEndpoint, strconv.Itoa(group.Index), apply)
+}
+
+// routingStaticRoute represents a single static route, pfSense identifies static routes by their position in the list.
+type routingStaticRoute struct {
+	Index    int                      `json:"-"`
+	Network  string                   `json:"network"`
+	Gateway  string                   `json:"gateway"`
+	Disabled pfsenseapi.TrueIfPresent `json:"disabled"`
+	Descr    string                   `json:"descr"`
+}
+
+func (r *routingStaticRoute) setId(id string) {
+	r.Index, _ = strconv.Atoi(id)
+}
+
+type routingStaticRouteRequest struct {
+	Descr    string `json:"descr,omitempty"`
+	Disabled bool   `json:"disabled"`
+	Gateway  string `json:"gateway"`
+	Network  string `json:"network"`
+}
+
+func listRoutingStaticRoutes(ctx context.Context, client *apiClient) ([]*routingStaticRoute, error) {
+	return apiListIndexed[routingStaticRoute](ctx, client, routingStaticRouteEndpoint)
+}
+
+func getRoutingStaticRoute(ctx context.Context, client *apiClient, network string) (*routingStaticRoute, error) {
+	list, err := listRoutingStaticRoutes(ctx, client)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, route := range list {
+		if route.Network == network {
+			return route, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Unable to find static route %s", network)
+}
+
+func createRoutingStaticRoute(ctx context.Context, client *apiClient, request routingStaticRouteRequest, apply bool) (*routingStaticRoute, error) {
+	return apiCreateIndexed[routingStaticRoute](ctx, client, routingStaticRouteEndpoint, request, apply)
+}
+
+func updateRoutingStaticRoute(ctx context.Context, client *apiClient, network string, request routingStaticRouteRequest, apply bool) (*routingStaticRoute, error) {
+	route, err := getRoutingStaticRoute(ctx, client, network)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return apiUpdateIndexed[routingStaticRoute](ctx, client, routingStaticRouteEndpoint, strconv.Itoa(route.Index), request, apply)
+}
+
+func deleteRoutingStaticRoute(ctx context.Context, client *apiClient, network string, apply bool) error {
+	route, err := getRoutingStaticRoute(ctx, client, network)
+
+	if err != nil {
+		return err
+	}
+
+	return apiDeleteIndexed(ctx, client, routingStaticRouteEndpoint, strconv.Itoa(route.Index), apply)
+}
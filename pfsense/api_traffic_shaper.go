@@ -0,0 +1,244 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+const (
+	trafficShaperEndpoint        = "api/v1/firewall/traffic_shaper"
+	trafficShaperQueueEndpoint   = "api/v1/firewall/traffic_shaper/queue"
+	trafficShaperLimiterEndpoint = "api/v1/firewall/traffic_shaper/limiter"
+)
+
+// trafficShaperLimiter represents a single dummynet limiter, pfSense identifies limiters by their position in the
+// list.
+type trafficShaperLimiter struct {
+	Index       int                              `json:"-"`
+	Name        string                           `json:"name"`
+	Number      pfsenseapi.OptionalJSONInt       `json:"number"`
+	Enabled     string                           `json:"enabled"`
+	Bandwidth   *trafficShaperLimiterBandwidth   `json:"bandwidth,omitempty"`
+	Mask        string                           `json:"mask"`
+	MaskBits    pfsenseapi.OptionalJSONInt       `json:"maskbits"`
+	MaskBitsV6  pfsenseapi.OptionalJSONInt       `json:"maskbitsv6"`
+	Scheduler   string                           `json:"sched"`
+	AQM         string                           `json:"aqm"`
+	Delay       pfsenseapi.OptionalJSONInt       `json:"delay"`
+	Description string                           `json:"description"`
+	Queue       []*trafficShaperLimiterQueueItem `json:"queue,omitempty"`
+}
+
+func (l *trafficShaperLimiter) setId(id string) {
+	l.Index, _ = strconv.Atoi(id)
+}
+
+type trafficShaperLimiterBandwidth struct {
+	Item []*trafficShaperLimiterBandwidthItem `json:"item"`
+}
+
+type trafficShaperLimiterBandwidthItem struct {
+	Bandwidth      pfsenseapi.OptionalJSONInt `json:"bw"`
+	BandwidthScale string                     `json:"bwscale"`
+}
+
+type trafficShaperLimiterQueueItem struct {
+	Name        string                     `json:"name"`
+	Enabled     string                     `json:"enabled"`
+	Mask        string                     `json:"mask"`
+	MaskBits    pfsenseapi.OptionalJSONInt `json:"maskbits"`
+	MaskBitsV6  pfsenseapi.OptionalJSONInt `json:"maskbitsv6"`
+	AQM         string                     `json:"aqm"`
+	Weight      pfsenseapi.OptionalJSONInt `json:"weight"`
+	Description string                     `json:"description"`
+}
+
+type trafficShaperLimiterRequest struct {
+	AQM         string                                  `json:"aqm"`
+	Bandwidth   []*trafficShaperLimiterBandwidthRequest `json:"bandwidth"`
+	Delay       int                                     `json:"delay,omitempty"`
+	Description string                                  `json:"description,omitempty"`
+	Enabled     bool                                    `json:"enabled"`
+	Mask        string                                  `json:"mask"`
+	MaskBits    int                                     `json:"maskbits,omitempty"`
+	MaskBitsV6  int                                     `json:"maskbitsv6,omitempty"`
+	Name        string                                  `json:"name"`
+	Queue       []*trafficShaperLimiterQueueRequest     `json:"queue"`
+	Scheduler   string                                  `json:"sched"`
+}
+
+type trafficShaperLimiterBandwidthRequest struct {
+	Bandwidth      int    `json:"bw"`
+	BandwidthScale string `json:"bwscale"`
+}
+
+type trafficShaperLimiterQueueRequest struct {
+	AQM         string `json:"aqm"`
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled"`
+	Mask        string `json:"mask"`
+	MaskBits    int    `json:"maskbits,omitempty"`
+	MaskBitsV6  int    `json:"maskbitsv6,omitempty"`
+	Name        string `json:"name"`
+	Weight      int    `json:"weight,omitempty"`
+}
+
+func listTrafficShaperLimiters(ctx context.Context, client *apiClient) ([]*trafficShaperLimiter, error) {
+	return apiListIndexed[trafficShaperLimiter](ctx, client, trafficShaperLimiterEndpoint)
+}
+
+func getTrafficShaperLimiter(ctx context.Context, client *apiClient, name string) (*trafficShaperLimiter, error) {
+	list, err := listTrafficShaperLimiters(ctx, client)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, limiter := range list {
+		if limiter.Name == name {
+			return limiter, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w with name %s", errNotFound, name)
+}
+
+func createTrafficShaperLimiter(ctx context.Context, client *apiClient, request trafficShaperLimiterRequest, apply bool) (*trafficShaperLimiter, error) {
+	return apiCreateIndexed[trafficShaperLimiter](ctx, client, trafficShaperLimiterEndpoint, request, apply)
+}
+
+func updateTrafficShaperLimiter(ctx context.Context, client *apiClient, name string, request trafficShaperLimiterRequest, apply bool) (*trafficShaperLimiter, error) {
+	limiter, err := getTrafficShaperLimiter(ctx, client, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return apiUpdateIndexed[trafficShaperLimiter](ctx, client, trafficShaperLimiterEndpoint, strconv.Itoa(limiter.Index), request, apply)
+}
+
+func deleteTrafficShaperLimiter(ctx context.Context, client *apiClient, name string, apply bool) error {
+	limiter, err := getTrafficShaperLimiter(ctx, client, name)
+
+	if err != nil {
+		return err
+	}
+
+	return apiDeleteIndexed(ctx, client, trafficShaperLimiterEndpoint, strconv.Itoa(limiter.Index), apply)
+}
+
+// trafficShaper represents the ALTQ shaper of a single interface, the queues of an interface are children of it.
+type trafficShaper struct {
+	Interface string                `json:"interface"`
+	Name      string                `json:"name"`
+	Scheduler string                `json:"scheduler"`
+	Queue     []*trafficShaperQueue `json:"queue,omitempty"`
+}
+
+type trafficShaperQueue struct {
+	Name          string                     `json:"name"`
+	Interface     string                     `json:"interface"`
+	Priority      pfsenseapi.OptionalJSONInt `json:"priority"`
+	QLimit        pfsenseapi.OptionalJSONInt `json:"qlimit"`
+	Bandwidth     pfsenseapi.OptionalJSONInt `json:"bandwidth"`
+	BandwidthType string                     `json:"bandwidthtype"`
+	Default       pfsenseapi.TrueIfPresent   `json:"default"`
+	ECN           pfsenseapi.TrueIfPresent   `json:"ecn"`
+	Codel         pfsenseapi.TrueIfPresent   `json:"codel"`
+	Enabled       string                     `json:"enabled"`
+	Description   string                     `json:"description"`
+}
+
+type trafficShaperQueueRequest struct {
+	Bandwidth     int    `json:"bandwidth,omitempty"`
+	BandwidthType string `json:"bandwidthtype,omitempty"`
+	Codel         bool   `json:"codel"`
+	Default       bool   `json:"default"`
+	Description   string `json:"description,omitempty"`
+	ECN           bool   `json:"ecn"`
+	Enabled       bool   `json:"enabled"`
+	Interface     string `json:"interface"`
+	Name          string `json:"name"`
+	Priority      int    `json:"priority"`
+	QLimit        int    `json:"qlimit,omitempty"`
+}
+
+func listTrafficShaperQueues(ctx context.Context, client *apiClient, iface string) ([]*trafficShaperQueue, error) {
+	shapers, err := apiRequest[[]*trafficShaper](ctx, client, http.MethodGet, trafficShaperEndpoint, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, shaper := range shapers {
+		if shaper.Interface != iface {
+			continue
+		}
+
+		for _, queue := range shaper.Queue {
+			queue.Interface = shaper.Interface
+		}
+
+		return shaper.Queue, nil
+	}
+
+	return []*trafficShaperQueue{}, nil
+}
+
+func getTrafficShaperQueue(ctx context.Context, client *apiClient, iface string, name string) (*trafficShaperQueue, error) {
+	list, err := listTrafficShaperQueues(ctx, client, iface)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, queue := range list {
+		if queue.Name == name {
+			return queue, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w with name %s on interface %s", errNotFound, name, iface)
+}
+
+func createTrafficShaperQueue(ctx context.Context, client *apiClient, request trafficShaperQueueRequest, apply bool) (*trafficShaperQueue, error) {
+	body, err := apiWriteRequest(request, map[string]interface{}{"apply": apply})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := apiRequest[interface{}](ctx, client, http.MethodPost, trafficShaperQueueEndpoint, nil, body); err != nil {
+		return nil, err
+	}
+
+	return getTrafficShaperQueue(ctx, client, request.Interface, request.Name)
+}
+
+func updateTrafficShaperQueue(ctx context.Context, client *apiClient, request trafficShaperQueueRequest, apply bool) (*trafficShaperQueue, error) {
+	body, err := apiWriteRequest(request, map[string]interface{}{"apply": apply})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := apiRequest[interface{}](ctx, client, http.MethodPut, trafficShaperQueueEndpoint, nil, body); err != nil {
+		return nil, err
+	}
+
+	return getTrafficShaperQueue(ctx, client, request.Interface, request.Name)
+}
+
+func deleteTrafficShaperQueue(ctx context.Context, client *apiClient, iface string, name string, apply bool) error {
+	_, err := apiRequest[interface{}](ctx, client, http.MethodDelete, trafficShaperQueueEndpoint, map[string]string{
+		"interface": iface,
+		"name":      name,
+		"apply":     strconv.FormatBool(apply),
+	}, nil)
+
+	return err
+}
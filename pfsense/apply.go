@@ -0,0 +1,195 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	applyModeImmediate = "immediate"
+	applyModeDeferred  = "deferred"
+)
+
+const (
+	applySubsystemInterfaces = "interfaces"
+	applySubsystemRouting    = "routing"
+	applySubsystemFilter     = "filter"
+	applySubsystemUnbound    = "unbound"
+	applySubsystemDHCP       = "dhcp"
+)
+
+const (
+	unboundApplyEndpoint = "api/v1/services/unbound/apply"
+	dhcpRestartEndpoint  = "api/v1/services/dhcpd/restart"
+)
+
+// Subsystems in the order they are applied, interfaces come first as routing and the filter depend on them.
+var applySubsystems = []string{
+	applySubsystemInterfaces,
+	applySubsystemRouting,
+	applySubsystemFilter,
+	applySubsystemUnbound,
+	applySubsystemDHCP,
+}
+
+// dirtySubsystemNames are the names pfSense marks subsystems with changes that haven't been applied by. The marks are
+// shown by its web interface and outlive the provider, so changes deferred by a run that failed or didn't apply them are
+// applied by a later run. DHCP changes are always applied by pfSense as they are made.
+var dirtySubsystemNames = map[string]string{
+	applySubsystemInterfaces: "interfaces",
+	applySubsystemRouting:    "staticroutes",
+	applySubsystemFilter:     "filter",
+	applySubsystemUnbound:    "unbound",
+}
+
+const dirtyMarkPath = "/var/run/%s.dirty"
+
+// shouldApply returns whether a change to the subsystem should be applied straight away, when changes are deferred
+// the subsystem is recorded as pending instead.
+func shouldApply(client *apiClient, subsystem string) bool {
+	if client.applyMode != applyModeDeferred {
+		return true
+	}
+
+	client.applyLock.Lock()
+	defer client.applyLock.Unlock()
+
+	client.pending[subsystem] = true
+
+	return false
+}
+
+func applySubsystem(ctx context.Context, client *apiClient, subsystem string) error {
+	switch subsystem {
+	case applySubsystemInterfaces:
+		return client.Interface.Apply(ctx, false)
+	case applySubsystemRouting:
+		return client.Routing.Apply(ctx)
+	case applySubsystemFilter:
+		return client.Firewall.Apply(ctx)
+	case applySubsystemUnbound:
+		_, err := apiRequest[interface{}](ctx, client, http.MethodPost, unboundApplyEndpoint, nil, map[string]interface{}{})
+		return err
+	case applySubsystemDHCP:
+		_, err := apiRequest[interface{}](ctx, client, http.MethodPost, dhcpRestartEndpoint, nil, map[string]interface{}{})
+		return err
+	}
+
+	return fmt.Errorf("Unable to apply unknown subsystem %s", subsystem)
+}
+
+// markPending marks the subsystems with changes deferred by the provider as dirty on pfSense.
+func (c *apiClient) markPending(ctx context.Context) error {
+	c.applyLock.Lock()
+	defer c.applyLock.Unlock()
+
+	var marked []string
+	var paths []string
+
+	for _, subsystem := range applySubsystems {
+		name, ok := dirtySubsystemNames[subsystem]
+
+		if !ok || !c.pending[subsystem] || c.dirty[subsystem] {
+			continue
+		}
+
+		marked = append(marked, subsystem)
+		paths = append(paths, shellQuote(fmt.Sprintf(dirtyMarkPath, name)))
+	}
+
+	if len(marked) == 0 {
+		return nil
+	}
+
+	if err := runShellCommand(ctx, c, fmt.Sprintf("touch %s", strings.Join(paths, " "))); err != nil {
+		return fmt.Errorf("Unable to mark the changes to %s as pending on pfSense: %v", strings.Join(marked, ", "), err)
+	}
+
+	for _, subsystem := range marked {
+		c.dirty[subsystem] = true
+	}
+
+	return nil
+}
+
+// dirtySubsystems returns the subsystems pfSense has marked as dirty, in the order they are applied.
+func dirtySubsystems(ctx context.Context, client *apiClient) ([]string, error) {
+	var checks []string
+
+	for _, subsystem := range applySubsystems {
+		if name, ok := dirtySubsystemNames[subsystem]; ok {
+			checks = append(checks, fmt.Sprintf("[ -f %s ] && echo %s", shellQuote(fmt.Sprintf(dirtyMarkPath, name)), subsystem))
+		}
+	}
+
+	output, err := runShellCommandOutput(ctx, client, strings.Join(checks, "; ")+"; true")
+
+	if err != nil {
+		return nil, fmt.Errorf("Unable to find the changes pending on pfSense: %v", err)
+	}
+
+	dirty := map[string]bool{}
+
+	for _, subsystem := range strings.Fields(output) {
+		dirty[subsystem] = true
+	}
+
+	subsystems := []string{}
+
+	for _, subsystem := range applySubsystems {
+		if dirty[subsystem] {
+			subsystems = append(subsystems, subsystem)
+		}
+	}
+
+	return subsystems, nil
+}
+
+// applyPending applies the given subsystems and every subsystem with pending changes, whether they were deferred by
+// this run or are marked as dirty on pfSense, and returns the subsystems that were applied.
+func applyPending(ctx context.Context, client *apiClient, subsystems []string) ([]string, error) {
+	client.applyLock.Lock()
+	defer client.applyLock.Unlock()
+
+	dirty, err := dirtySubsystems(ctx, client)
+
+	if err != nil {
+		return nil, err
+	}
+
+	requested := map[string]bool{}
+
+	for _, subsystem := range subsystems {
+		requested[subsystem] = true
+	}
+
+	for _, subsystem := range dirty {
+		requested[subsystem] = true
+	}
+
+	applied := []string{}
+
+	for _, subsystem := range applySubsystems {
+		if !requested[subsystem] && !client.pending[subsystem] {
+			continue
+		}
+
+		if err := applySubsystem(ctx, client, subsystem); err != nil {
+			return applied, fmt.Errorf("Unable to apply %s changes: %v", subsystem, err)
+		}
+
+		if name, ok := dirtySubsystemNames[subsystem]; ok {
+			if err := runShellCommand(ctx, client, fmt.Sprintf("rm -f %s", shellQuote(fmt.Sprintf(dirtyMarkPath, name)))); err != nil {
+				return applied, fmt.Errorf("Unable to clear the pending changes to %s on pfSense: %v", subsystem, err)
+			}
+		}
+
+		delete(client.pending, subsystem)
+		delete(client.dirty, subsystem)
+		applied = append(applied, subsystem)
+	}
+
+	return applied, nil
+}
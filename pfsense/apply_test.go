@@ -0,0 +1,206 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+// testApplyServer records the requests to apply subsystems and keeps the dirty marks the provider creates and removes
+// with shell commands, marks set in dirty are left by an earlier run.
+func testApplyServer(t *testing.T, applyMode string, dirty ...string) (*apiClient, func() []string, func() []string) {
+	var lock sync.Mutex
+	var paths []string
+	marks := map[string]bool{}
+
+	for _, subsystem := range dirty {
+		marks[dirtySubsystemNames[subsystem]] = true
+	}
+
+	markPath := regexp.MustCompile(`/var/run/([a-z]+)\.dirty`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if r.URL.Path != "/"+commandPromptEndpoint {
+			paths = append(paths, r.URL.Path)
+			fmt.Fprint(w, `{"status": "ok", "code": 200, "return": 0, "message": "Success", "data": []}`)
+			return
+		}
+
+		var body struct {
+			ShellCmd string `json:"shell_cmd"`
+		}
+
+		json.NewDecoder(r.Body).Decode(&body)
+		var output []string
+
+		for _, match := range markPath.FindAllStringSubmatch(body.ShellCmd, -1) {
+			switch {
+			case strings.HasPrefix(body.ShellCmd, "touch "):
+				marks[match[1]] = true
+			case strings.HasPrefix(body.ShellCmd, "rm -f "):
+				delete(marks, match[1])
+			case marks[match[1]]:
+				for subsystem, name := range dirtySubsystemNames {
+					if name == match[1] {
+						output = append(output, subsystem)
+					}
+				}
+			}
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"status": "ok", "code": 200, "return": 0, "message": "Success", "data": map[string]string{"cmd_output": strings.Join(output, "\n")}})
+	}))
+
+	t.Cleanup(server.Close)
+
+	config := pfsenseapi.Config{Host: server.URL, LocalAuthEnabled: true, User: "admin", Password: "pfsense"}
+
+	return testAPIClient(t, config, apiClientSettings{applyMode: applyMode}), func() []string {
+			lock.Lock()
+			defer lock.Unlock()
+
+			return paths
+		}, func() []string {
+			lock.Lock()
+			defer lock.Unlock()
+
+			var names []string
+
+			for name := range marks {
+				names = append(names, name)
+			}
+
+			sort.Strings(names)
+
+			return names
+		}
+}
+
+func Test_ImmediateModeAppliesEveryChange(t *testing.T) {
+	client, paths, _ := testApplyServer(t, applyModeImmediate)
+
+	if !shouldApply(client, applySubsystemFilter) {
+		t.Errorf("Changes should be applied straight away in immediate mode")
+	}
+
+	applied, err := applyPending(context.Background(), client, nil)
+
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if len(applied) != 0 || len(paths()) != 0 {
+		t.Errorf("Nothing should be pending in immediate mode but applied %v", applied)
+	}
+}
+
+func Test_DeferredModeAppliesEachSubsystemOnce(t *testing.T) {
+	client, paths, marks := testApplyServer(t, applyModeDeferred)
+
+	for _, subsystem := range []string{applySubsystemFilter, applySubsystemUnbound, applySubsystemFilter, applySubsystemInterfaces} {
+		err := client.write(context.Background(), func() error {
+			if shouldApply(client, subsystem) {
+				t.Errorf("Changes to %s shouldn't be applied straight away in deferred mode", subsystem)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+	}
+
+	if fmt.Sprint(marks()) != "[filter interfaces unbound]" {
+		t.Errorf("Expected the deferred changes to be marked as pending on pfSense but found %v", marks())
+	}
+
+	applied, err := applyPending(context.Background(), client, nil)
+
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	expected := []string{"/api/v1/interface/apply", "/api/v1/firewall/apply", "/" + unboundApplyEndpoint}
+
+	if fmt.Sprint(applied) != fmt.Sprint([]string{applySubsystemInterfaces, applySubsystemFilter, applySubsystemUnbound}) {
+		t.Errorf("Applied %v in the wrong order", applied)
+	}
+
+	if fmt.Sprint(paths()) != fmt.Sprint(expected) {
+		t.Errorf("Expected requests to %v but found %v", expected, paths())
+	}
+
+	if len(marks()) != 0 {
+		t.Errorf("Expected the pending marks to be cleared once applied but found %v", marks())
+	}
+
+	if applied, _ = applyPending(context.Background(), client, nil); len(applied) != 0 {
+		t.Errorf("Pending changes should be cleared once applied but applied %v again", applied)
+	}
+
+	if applied, _ = applyPending(context.Background(), client, []string{applySubsystemDHCP}); fmt.Sprint(applied) != fmt.Sprint([]string{applySubsystemDHCP}) {
+		t.Errorf("Requested subsystems should be applied without pending changes but applied %v", applied)
+	}
+}
+
+func Test_ChangesLeftPendingAreApplied(t *testing.T) {
+	client, paths, marks := testApplyServer(t, applyModeDeferred, applySubsystemRouting)
+
+	applied, err := applyPending(context.Background(), client, []string{applySubsystemFilter})
+
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if fmt.Sprint(applied) != fmt.Sprint([]string{applySubsystemRouting, applySubsystemFilter}) || len(marks()) != 0 {
+		t.Errorf("Expected the routing changes left by an earlier run to be applied but applied %v with %v", applied, paths())
+	}
+}
+
+func Test_ApplyIsPlannedWhileChangesArePending(t *testing.T) {
+	client, _, _ := testApplyServer(t, applyModeDeferred)
+	resource := Provider().ResourcesMap["pfsense_apply"]
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"triggers": map[string]interface{}{"rules": "1"}})
+
+	tests := map[string]struct {
+		pending  []string
+		expected bool
+	}{
+		"nothingPending": {},
+		"pending":        {pending: []string{applySubsystemFilter}, expected: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			attributes := map[string]string{"id": applyId, "triggers.%": "1", "triggers.rules": "1", "applied.#": "0", "endpoint_ids.%": "0", "pending.#": fmt.Sprint(len(test.pending))}
+
+			for i, subsystem := range test.pending {
+				attributes[fmt.Sprintf("pending.%d", i)] = subsystem
+			}
+
+			diff, err := resource.Diff(context.Background(), &terraform.InstanceState{ID: applyId, Attributes: attributes}, config, client)
+
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			if planned := diff != nil && !diff.Empty(); planned != test.expected {
+				t.Errorf("Expected an update to be planned to be %v but found %v", test.expected, diff)
+			}
+		})
+	}
+}
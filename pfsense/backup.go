@@ -0,0 +1,158 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+// configHistoryEndpoint lists and deletes the backups in the configuration history, the API has no endpoints to take
+// or restore them so the provider runs pfSense's own functions for that.
+const configHistoryEndpoint = "api/v1/diagnostics/config_history"
+
+// configBackup is a copy of config.xml kept by pfSense in its configuration history. pfSense adds one whenever it
+// writes the configuration, named after the time of the change, which is used as its ID.
+type configBackup struct {
+	Time        pfsenseapi.JSONInt `json:"time"`
+	Description string             `json:"description"`
+}
+
+type configBackupRequest struct {
+	Description string
+}
+
+func listConfigBackups(ctx context.Context, client *apiClient) ([]*configBackup, error) {
+	return apiRequest[[]*configBackup](ctx, client, http.MethodGet, configHistoryEndpoint, nil, nil)
+}
+
+// newestConfigBackup returns the most recent backup in the configuration history with the description, or with any
+// description when it's empty.
+func newestConfigBackup(ctx context.Context, client *apiClient, description string) (*configBackup, error) {
+	backups, err := listConfigBackups(ctx, client)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var newest *configBackup
+
+	for _, backup := range backups {
+		if (description == "" || backup.Description == description) && (newest == nil || backup.Time > newest.Time) {
+			newest = backup
+		}
+	}
+
+	return newest, nil
+}
+
+// createConfigBackup writes the configuration with the description, which adds it to the configuration history.
+func createConfigBackup(ctx context.Context, client *apiClient, request configBackupRequest) (*configBackup, error) {
+	php := fmt.Sprintf(`require_once("config.inc"); write_config(%s);`, phpQuote(request.Description))
+
+	if err := runShellCommand(ctx, client, fmt.Sprintf("php -r %s", shellQuote(php))); err != nil {
+		return nil, err
+	}
+
+	backup, err := newestConfigBackup(ctx, client, request.Description)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if backup == nil {
+		return nil, fmt.Errorf("No backup was found in the configuration history after creating backup %s", request.Description)
+	}
+
+	return backup, nil
+}
+
+// deleteConfigBackup deletes a backup, the API identifies backups by their position in the configuration history.
+func deleteConfigBackup(ctx context.Context, client *apiClient, id string) error {
+	backups, err := listConfigBackups(ctx, client)
+
+	if err != nil {
+		return err
+	}
+
+	for i, backup := range backups {
+		if strconv.Itoa(int(backup.Time)) == id {
+			_, err := apiRequest[interface{}](ctx, client, http.MethodDelete, configHistoryEndpoint, map[string]string{"id": strconv.Itoa(i)}, nil)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreConfigBackup restores a backup in the background, as reloading the configuration restarts the API.
+func restoreConfigBackup(ctx context.Context, client *apiClient, id string) error {
+	return runShellCommand(ctx, client, backgroundCommand(restoreScript(fmt.Sprintf(configBackupPath, id))))
+}
+
+// takeSnapshot backs up the configuration before the first change made by the provider, so that there is a known
+// good configuration to go back to if the run goes wrong.
+func (c *apiClient) takeSnapshot(ctx context.Context) error {
+	c.snapshotLock.Lock()
+	defer c.snapshotLock.Unlock()
+
+	if c.rolledBack {
+		return fmt.Errorf("Unable to make changes, the configuration was restored from backup %s after an earlier change failed", c.snapshotId)
+	}
+
+	if !c.snapshot || c.snapshotId != "" {
+		return nil
+	}
+
+	// backup_config copies the configuration into the history, where it's the newest backup
+	php := `require_once("config.inc"); backup_config();`
+	err := runShellCommand(ctx, c, fmt.Sprintf("php -r %s", shellQuote(php)))
+	var backup *configBackup
+
+	if err == nil {
+		backup, err = newestConfigBackup(ctx, c, "")
+	}
+
+	if hasStatusCode(err, http.StatusNotFound) {
+		return fmt.Errorf("Unable to back up the configuration before making changes, the pfSense API on %s has no %s or %s endpoint. Update the API package or disable config_snapshot: %v", c.Cfg.Host, commandPromptEndpoint, configHistoryEndpoint, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("Unable to back up the configuration before making changes: %v", err)
+	}
+
+	if backup == nil {
+		return fmt.Errorf("Unable to back up the configuration before making changes, the configuration history of %s is empty", c.Cfg.Host)
+	}
+
+	c.snapshotId = strconv.Itoa(int(backup.Time))
+	log.Printf("[INFO] Backed up the pfSense configuration to %s before making changes", c.snapshotId)
+
+	return nil
+}
+
+// rollback restores the snapshot after a change failed, changes made afterwards are refused so that the run
+// doesn't carry on against the restored configuration.
+func (c *apiClient) rollback(ctx context.Context, changeErr error) error {
+	if !c.rollbackOnError {
+		return changeErr
+	}
+
+	c.snapshotLock.Lock()
+	defer c.snapshotLock.Unlock()
+
+	if c.snapshotId == "" || c.rolledBack {
+		return changeErr
+	}
+
+	if err := restoreConfigBackup(ctx, c, c.snapshotId); err != nil {
+		return fmt.Errorf("%v, unable to restore the configuration from backup %s: %v", changeErr, c.snapshotId, err)
+	}
+
+	c.rolledBack = true
+
+	return fmt.Errorf("%v, the configuration was restored from backup %s", changeErr, c.snapshotId)
+}
@@ -0,0 +1,176 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+// testConfigHistoryHandler answers like the API with a configuration history of two backups.
+func testConfigHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet && r.URL.Path == "/"+configHistoryEndpoint {
+		fmt.Fprint(w, `{"status": "ok", "code": 200, "return": 0, "message": "Success", "data": [{"time": "1700000000", "description": "admin@10.0.0.2: Added rule"}, {"time": "1690000000", "description": "snapshot"}]}`)
+		return
+	}
+
+	fmt.Fprint(w, `{"status": "ok", "code": 200, "return": 0, "message": "Success", "data": {}}`)
+}
+
+func testBackupServer(t *testing.T, settings apiClientSettings) (*apiClient, func() []string) {
+	var lock sync.Mutex
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests = append(requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+		lock.Unlock()
+
+		testConfigHistoryHandler(w, r)
+	}))
+
+	t.Cleanup(server.Close)
+
+	config := pfsenseapi.Config{Host: server.URL, LocalAuthEnabled: true, User: "admin", Password: "pfsense"}
+
+	return testAPIClient(t, config, settings), func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+
+		return requests
+	}
+}
+
+func Test_SnapshotIsTakenBeforeFirstChange(t *testing.T) {
+	client, requests := testBackupServer(t, apiClientSettings{snapshot: true})
+
+	for i := 0; i < 3; i++ {
+		if err := client.write(context.Background(), func() error { return nil }); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+	}
+
+	expected := []string{"POST /" + commandPromptEndpoint, "GET /" + configHistoryEndpoint}
+
+	if fmt.Sprint(requests()) != fmt.Sprint(expected) || client.snapshotId != "1700000000" {
+		t.Errorf("Expected a single backup but found %v with snapshot %s", requests(), client.snapshotId)
+	}
+}
+
+func Test_RollbackOnError(t *testing.T) {
+	tests := map[string]struct {
+		settings apiClientSettings
+		expected []string
+	}{
+		"disabled": {
+			settings: apiClientSettings{snapshot: true},
+			expected: []string{"POST /" + commandPromptEndpoint, "GET /" + configHistoryEndpoint},
+		},
+		"enabled": {
+			settings: apiClientSettings{snapshot: true, rollbackOnError: true},
+			expected: []string{"POST /" + commandPromptEndpoint, "GET /" + configHistoryEndpoint, "POST /" + commandPromptEndpoint},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, requests := testBackupServer(t, test.settings)
+
+			err := client.write(context.Background(), func() error { return fmt.Errorf("interface change failed") })
+
+			if err == nil || !strings.HasPrefix(err.Error(), "interface change failed") {
+				t.Fatalf("Expected the change error but found %v", err)
+			}
+
+			if fmt.Sprint(requests()) != fmt.Sprint(test.expected) {
+				t.Errorf("Expected requests to %v but found %v", test.expected, requests())
+			}
+
+			err = client.write(context.Background(), func() error { return nil })
+
+			if test.settings.rollbackOnError && err == nil {
+				t.Errorf("Expected changes to be refused after rolling back")
+			} else if !test.settings.rollbackOnError && err != nil {
+				t.Errorf("Unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func Test_SnapshotWithoutBackupEndpoints(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status": "not found", "code": 404, "return": 4, "message": "Endpoint not found", "data": []}`)
+	}))
+
+	t.Cleanup(server.Close)
+
+	config := pfsenseapi.Config{Host: server.URL, LocalAuthEnabled: true, User: "admin", Password: "pfsense"}
+	changed := false
+
+	err := testAPIClient(t, config, apiClientSettings{snapshot: true}).write(context.Background(), func() error {
+		changed = true
+		return nil
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "disable config_snapshot") || changed {
+		t.Errorf("Expected the change to be refused without a backup but found %v", err)
+	}
+
+	err = testAPIClient(t, config, apiClientSettings{}).write(context.Background(), func() error {
+		changed = true
+		return nil
+	})
+
+	if err != nil || !changed {
+		t.Errorf("Expected the change to be made without config_snapshot but found %v", err)
+	}
+}
+
+func Test_ConfigBackups(t *testing.T) {
+	var commands []string
+	var deleted []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/"+commandPromptEndpoint:
+			var body struct {
+				ShellCmd string `json:"shell_cmd"`
+			}
+
+			json.NewDecoder(r.Body).Decode(&body)
+			commands = append(commands, body.ShellCmd)
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Query().Get("id"))
+		}
+
+		testConfigHistoryHandler(w, r)
+	}))
+
+	t.Cleanup(server.Close)
+
+	client := testAPIClient(t, pfsenseapi.Config{Host: server.URL}, apiClientSettings{})
+	backup, err := createConfigBackup(context.Background(), client, configBackupRequest{Description: "snapshot"})
+
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if backup.Time != 1690000000 || fmt.Sprint(commands) != fmt.Sprint([]string{`php -r 'require_once("config.inc"); write_config('\''snapshot'\'');'`}) {
+		t.Errorf("Expected backup 1690000000 to be written by write_config but found %d and %v", backup.Time, commands)
+	}
+
+	if err := deleteConfigBackup(context.Background(), client, "1690000000"); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if fmt.Sprint(deleted) != "[1]" {
+		t.Errorf("Expected the backup to be deleted by its position 1 but found %v", deleted)
+	}
+}
@@ -0,0 +1,118 @@
+package pfsense
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+// apiClient wraps pfsenseapi.Client with the state the provider keeps for each configured pfSense.
+type apiClient struct {
+	*pfsenseapi.Client
+	httpClient *http.Client
+	writes     chan struct{}
+	applyMode  string
+	applyLock  sync.Mutex
+	pending    map[string]bool
+	dirty      map[string]bool
+
+	snapshot        bool
+	rollbackOnError bool
+	snapshotLock    sync.Mutex
+	snapshotId      string
+	rolledBack      bool
+	confirmWindow   time.Duration
+
+	name          string
+	endpoints     []*apiClient
+	defaultTarget string
+
+	versionLock   sync.Mutex
+	cachedVersion *pfsenseVersion
+
+	listCacheLock sync.Mutex
+	listCache     map[string]*listCacheEntry
+	listCacheTTL  time.Duration
+
+	// tokenLock guards jwtToken, the JWT used by apiRequest which refreshes it when it's rejected
+	tokenLock sync.Mutex
+	jwtToken  string
+
+	// indexLock is held while writing resources that pfSense identifies by their position in a list
+	indexLock sync.Mutex
+}
+
+// apiClientSettings are the provider settings that change how requests are made.
+type apiClientSettings struct {
+	maxConcurrentWrites int
+	applyMode           string
+	retry               retryPolicy
+	snapshot            bool
+	rollbackOnError     bool
+	confirmWindow       time.Duration
+	tls                 tlsSettings
+	readCacheTTL        time.Duration
+}
+
+func newAPIClient(config pfsenseapi.Config, settings apiClientSettings) (*apiClient, error) {
+	tlsConfig, err := newTLSConfig(settings.tls)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// pfsenseapi.Client sends its requests through the same http.Client as apiRequest
+	config.HTTPClient = &http.Client{
+		Timeout: config.Timeout,
+		Transport: &retryTransport{
+			base: &http.Transport{
+				TLSClientConfig: tlsConfig,
+			},
+			policy: settings.retry,
+		},
+	}
+
+	return &apiClient{
+		Client:     pfsenseapi.NewClient(config),
+		httpClient: config.HTTPClient,
+		writes:     make(chan struct{}, settings.maxConcurrentWrites),
+		applyMode:  settings.applyMode,
+		pending:    map[string]bool{},
+		dirty:      map[string]bool{},
+
+		snapshot:        settings.snapshot,
+		rollbackOnError: settings.rollbackOnError,
+		confirmWindow:   settings.confirmWindow,
+
+		listCacheTTL: settings.readCacheTTL,
+
+		jwtToken: config.JWTToken,
+	}, nil
+}
+
+// write runs a change once a write slot on the host is free, reads don't need a slot and can run at any time. The
+// configuration is backed up before the first change and restored if a change fails and rollback_on_error is set.
+func (c *apiClient) write(ctx context.Context, change func() error) error {
+	if c.writes != nil {
+		select {
+		case c.writes <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		defer func() { <-c.writes }()
+	}
+
+	if err := c.takeSnapshot(ctx); err != nil {
+		return err
+	}
+
+	if err := change(); err != nil {
+		return c.rollback(ctx, err)
+	}
+
+	return c.markPending(ctx)
+}
@@ -0,0 +1,144 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+func testAPIClient(t *testing.T, config pfsenseapi.Config, settings apiClientSettings) *apiClient {
+	if settings.maxConcurrentWrites == 0 {
+		settings.maxConcurrentWrites = 1
+	}
+
+	if settings.retry.maxAttempts == 0 {
+		settings.retry.maxAttempts = 1
+	}
+
+	client, err := newAPIClient(config, settings)
+
+	if err != nil {
+		t.Fatalf("Unable to create client: %v", err)
+	}
+
+	return client
+}
+
+func Test_WritesAreLimited(t *testing.T) {
+	client := testAPIClient(t, pfsenseapi.Config{Host: "https://write-limit.test"}, apiClientSettings{maxConcurrentWrites: 2})
+
+	var running, maxRunning int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			err := client.write(context.Background(), func() error {
+				current := atomic.AddInt32(&running, 1)
+
+				for {
+					previous := atomic.LoadInt32(&maxRunning)
+
+					if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+						break
+					}
+				}
+
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+
+				return nil
+			})
+
+			if err != nil {
+				t.Errorf("Unexpected error %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if maxRunning != 2 {
+		t.Errorf("Expected at most 2 concurrent writes to the host but found %d", maxRunning)
+	}
+}
+
+func Test_WriteStopsWaitingWhenCancelled(t *testing.T) {
+	client := testAPIClient(t, pfsenseapi.Config{Host: "https://write-cancel.test"}, apiClientSettings{})
+	release := make(chan struct{})
+
+	go client.write(context.Background(), func() error {
+		<-release
+		return nil
+	})
+
+	defer close(release)
+
+	// give the first write time to take the only slot
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := client.write(ctx, func() error { return nil }); err == nil {
+		t.Errorf("Expected the write to fail once the context was cancelled")
+	}
+}
+
+func Test_ExpiredTokenIsRefreshedOnce(t *testing.T) {
+	var tokens int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/access_token" {
+			fmt.Fprintf(w, `{"data": {"token": "token-%d"}}`, atomic.AddInt32(&tokens, 1))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message": "Token expired"}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"data": {}}`)
+	}))
+	defer server.Close()
+
+	client := testAPIClient(t, pfsenseapi.Config{
+		Host:           server.URL,
+		User:           "admin",
+		Password:       "pfsense",
+		JWTAuthEnabled: true,
+		JWTToken:       "expired",
+	}, apiClientSettings{})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, err := apiRequest[interface{}](context.Background(), client, http.MethodGet, "api/v1/system/version", nil, nil); err != nil {
+				t.Errorf("Unexpected error %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if tokens != 1 {
+		t.Errorf("Expected the expired token to be replaced once but %d tokens were created", tokens)
+	}
+}
@@ -0,0 +1,120 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	commandPromptEndpoint = "api/v1/diagnostics/command_prompt"
+	configBackupPath      = "/cf/conf/backup/config-%s.xml"
+)
+
+// commandOutput is what the API returns after running a shell command.
+type commandOutput struct {
+	Output string `json:"cmd_output"`
+}
+
+// runShellCommand runs a command on pfSense, it's used for what the API has no endpoint for.
+func runShellCommand(ctx context.Context, client *apiClient, command string) error {
+	_, err := runShellCommandOutput(ctx, client, command)
+	return err
+}
+
+// runShellCommandOutput runs a command on pfSense and returns what it printed.
+func runShellCommandOutput(ctx context.Context, client *apiClient, command string) (string, error) {
+	output, err := apiRequest[*commandOutput](ctx, client, http.MethodPost, commandPromptEndpoint, nil, map[string]interface{}{"shell_cmd": command})
+
+	if err != nil || output == nil {
+		return "", err
+	}
+
+	return output.Output, nil
+}
+
+// writeConfirmed makes a change that can cut the provider off from pfSense, like Junos' commit confirmed. Before the
+// change pfSense is told to restore the snapshot once the confirm window has passed, after the change the provider
+// checks it can still reach the API and cancels the restore. If pfSense can't be reached it restores itself.
+func (c *apiClient) writeConfirmed(ctx context.Context, change func() error) error {
+	return c.write(ctx, func() error {
+		if c.confirmWindow == 0 {
+			return change()
+		}
+
+		marker := fmt.Sprintf("/tmp/terraform-confirm-%d", time.Now().UnixNano())
+		armedAt := time.Now()
+		command := restoreCommand(fmt.Sprintf(configBackupPath, c.snapshotId), marker, c.confirmWindow)
+
+		if err := runShellCommand(ctx, c, command); err != nil {
+			return fmt.Errorf("Unable to schedule the restore of backup %s before making the change: %v", c.snapshotId, err)
+		}
+
+		changeErr := change()
+
+		// leave a quarter of the window for the confirmation to reach pfSense
+		if !c.waitUntilReachable(ctx, armedAt.Add(c.confirmWindow*3/4)) {
+			return fmt.Errorf("Unable to reach pfSense after the change, it will restore backup %s at %s", c.snapshotId, armedAt.Add(c.confirmWindow).Format(time.RFC3339))
+		}
+
+		if err := runShellCommand(ctx, c, fmt.Sprintf("touch %s", marker)); err != nil {
+			return fmt.Errorf("Unable to confirm the change, pfSense will restore backup %s at %s: %v", c.snapshotId, armedAt.Add(c.confirmWindow).Format(time.RFC3339), err)
+		}
+
+		return changeErr
+	})
+}
+
+// restoreCommand is the shell command that restores a backup in the background once the window has passed, unless the
+// marker file has been created by then.
+func restoreCommand(backupPath string, marker string, window time.Duration) string {
+	return backgroundCommand(fmt.Sprintf("sleep %d; [ -f %s ] || (%s)", int(window.Seconds()), shellQuote(marker), restoreScript(backupPath)))
+}
+
+// restoreScript restores a backup and reloads the configuration.
+func restoreScript(backupPath string) string {
+	php := fmt.Sprintf(`require_once("config.lib.inc"); config_restore(%s);`, phpQuote(backupPath))
+	return fmt.Sprintf("php -r %s && /etc/rc.reload_all", shellQuote(php))
+}
+
+// backgroundCommand runs a script after the API has answered, so that it can restart the API.
+func backgroundCommand(script string) string {
+	return fmt.Sprintf("nohup sh -c %s > /dev/null 2>&1 &", shellQuote(script))
+}
+
+// shellQuote quotes a value as a single argument of a shell command.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// phpQuote quotes a value as a PHP string literal.
+func phpQuote(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(value) + "'"
+}
+
+// waitUntilReachable polls the API until it answers or the deadline passes.
+func (c *apiClient) waitUntilReachable(ctx context.Context, deadline time.Time) bool {
+	interval := min(2*time.Second, c.confirmWindow/10)
+
+	for {
+		pollCtx, cancel := context.WithDeadline(ctx, deadline)
+		_, err := c.System.GetVersion(pollCtx)
+		cancel()
+
+		if err == nil {
+			return true
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return false
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+func Test_CommitConfirm(t *testing.T) {
+	tests := map[string]struct {
+		reachable bool
+		expected  []string
+	}{
+		"reachable": {
+			reachable: true,
+			expected: []string{
+				"POST /" + commandPromptEndpoint,
+				"GET /" + configHistoryEndpoint,
+				"POST /" + commandPromptEndpoint,
+				"GET /api/v1/system/version",
+				"POST /" + commandPromptEndpoint,
+			},
+		},
+		"unreachable": {
+			expected: []string{
+				"POST /" + commandPromptEndpoint,
+				"GET /" + configHistoryEndpoint,
+				"POST /" + commandPromptEndpoint,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var lock sync.Mutex
+			var requests []string
+			var commands []string
+			var down atomic.Bool
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if down.Load() {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+
+				lock.Lock()
+				requests = append(requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+
+				if r.URL.Path == "/"+commandPromptEndpoint {
+					var body struct {
+						ShellCmd string `json:"shell_cmd"`
+					}
+
+					json.NewDecoder(r.Body).Decode(&body)
+					commands = append(commands, body.ShellCmd)
+				}
+
+				lock.Unlock()
+
+				testConfigHistoryHandler(w, r)
+			}))
+
+			defer server.Close()
+
+			config := pfsenseapi.Config{Host: server.URL, LocalAuthEnabled: true, User: "admin", Password: "pfsense"}
+			client := testAPIClient(t, config, apiClientSettings{snapshot: true, confirmWindow: 400 * time.Millisecond})
+
+			err := client.writeConfirmed(context.Background(), func() error {
+				down.Store(!test.reachable)
+				return nil
+			})
+
+			if test.reachable && err != nil {
+				t.Errorf("Unexpected error %v", err)
+			} else if !test.reachable && (err == nil || !strings.Contains(err.Error(), "restore backup 1700000000")) {
+				t.Errorf("Expected an error saying the backup will be restored but found %v", err)
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			if fmt.Sprint(requests) != fmt.Sprint(test.expected) {
+				t.Errorf("Expected requests to %v but found %v", test.expected, requests)
+			}
+
+			// the first command backs up the configuration
+			if len(commands) < 2 {
+				t.Fatalf("Expected the restore to be scheduled")
+			}
+
+			marker := regexp.MustCompile(`/tmp/terraform-confirm-[0-9]+`).FindString(commands[1])
+			expected := restoreCommand("/cf/conf/backup/config-1700000000.xml", marker, 400*time.Millisecond)
+
+			if commands[1] != expected {
+				t.Errorf("Expected the restore to be scheduled with %s but found %s", expected, commands[1])
+			}
+
+			if test.reachable && (len(commands) != 3 || commands[2] != "touch "+marker) {
+				t.Errorf("Expected the change to be confirmed by creating %s but found %v", marker, commands[2:])
+			}
+		})
+	}
+}
+
+func Test_RestoreCommand(t *testing.T) {
+	command := restoreCommand("/cf/conf/backup/config-1700000000.xml", "/tmp/terraform-confirm-1", 90*time.Second)
+	expected := `nohup sh -c 'sleep 90; [ -f '\''/tmp/terraform-confirm-1'\'' ] || (php -r '\''require_once("config.lib.inc"); config_restore('\''\'\'''\''/cf/conf/backup/config-1700000000.xml'\''\'\'''\'');'\'' && /etc/rc.reload_all)' > /dev/null 2>&1 &`
+
+	if command != expected {
+		t.Errorf("Expected\n%s\nbut got\n%s", expected, command)
+	}
+
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is needed to run the command")
+	}
+
+	if output, err := exec.Command("sh", "-n", "-c", command).CombinedOutput(); err != nil {
+		t.Fatalf("Unable to parse the command: %v %s", err, output)
+	}
+
+	// run the command with php, sleep and nohup replaced by scripts recording what php was asked to run
+	bin := t.TempDir()
+	phpArgs := filepath.Join(bin, "php-args")
+	fakes := map[string]string{
+		"nohup": `exec "$@"`,
+		"sleep": `exit 0`,
+		"php":   fmt.Sprintf(`printf '%%s\n' "$@" > %s.tmp && mv %s.tmp %s`, phpArgs, phpArgs, phpArgs),
+	}
+
+	for name, script := range fakes {
+		if err := os.WriteFile(filepath.Join(bin, name), []byte("#!/bin/sh\n"+script+"\n"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Env = append(os.Environ(), "PATH="+bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("Unable to run the command: %v %s", err, output)
+	}
+
+	var args []byte
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if data, err := os.ReadFile(phpArgs); err == nil {
+			args = data
+			break
+		}
+	}
+
+	expectedArgs := "-r\nrequire_once(\"config.lib.inc\"); config_restore('/cf/conf/backup/config-1700000000.xml');\n"
+
+	if string(args) != expectedArgs {
+		t.Errorf("Expected php to be run with\n%s\nbut got\n%s", expectedArgs, args)
+	}
+}
@@ -0,0 +1,120 @@
+package pfsense
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const defaultCredentialsProfile = "default"
+
+// Settings that can be kept in a profile of the credentials file instead of the provider block.
+var credentialsFileKeys = []string{"url", "user", "password", "jwt_token", "api_client_id", "api_client_token"}
+
+func defaultCredentialsFile() string {
+	home, err := os.UserHomeDir()
+
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".pfsense", "credentials")
+}
+
+// readCredentialsProfile reads a profile from a credentials file, the file holds a section per firewall e.g.
+//
+//	[home]
+//	url              = https://192.168.1.1
+//	api_client_id    = id
+//	api_client_token = token
+func readCredentialsProfile(path string, profile string) (map[string]string, bool, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, false, fmt.Errorf("Unable to read credentials file %s: %v", path, err)
+	}
+
+	defer file.Close()
+
+	var section string
+	var found bool
+	values := map[string]string{}
+	scanner := bufio.NewScanner(file)
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			found = found || section == profile
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+
+		if !ok {
+			return nil, false, fmt.Errorf("Unable to parse line %d of credentials file %s, expected key = value", lineNumber, path)
+		}
+
+		key = strings.TrimSpace(key)
+
+		if !slices.Contains(credentialsFileKeys, key) {
+			return nil, false, fmt.Errorf("Unknown setting %s on line %d of credentials file %s, expected one of %s", key, lineNumber, path, strings.Join(credentialsFileKeys, ", "))
+		}
+
+		if section == profile {
+			values[key] = strings.TrimSpace(value)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, false, fmt.Errorf("Unable to read credentials file %s: %v", path, err)
+	}
+
+	return values, found, nil
+}
+
+// loadCredentialsProfile reads the profile configured on the provider. The default credentials file and the default
+// profile are optional, it's only an error for them to be missing when they are set explicitly.
+func loadCredentialsProfile(path string, profile string) (map[string]string, error) {
+	explicitProfile := profile != ""
+
+	if !explicitProfile {
+		profile = defaultCredentialsProfile
+	}
+
+	if home, err := os.UserHomeDir(); err == nil && strings.HasPrefix(path, "~/") {
+		path = filepath.Join(home, path[2:])
+	}
+
+	if path == "" {
+		path = defaultCredentialsFile()
+
+		if _, err := os.Stat(path); path == "" || errors.Is(err, os.ErrNotExist) {
+			if explicitProfile {
+				return nil, fmt.Errorf("Unable to use profile %s, credentials_file isn't set and %s doesn't exist", profile, path)
+			}
+
+			return map[string]string{}, nil
+		}
+	}
+
+	values, found, err := readCredentialsProfile(path, profile)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !found && explicitProfile {
+		return nil, fmt.Errorf("Unable to find profile %s in credentials file %s", profile, path)
+	}
+
+	return values, nil
+}
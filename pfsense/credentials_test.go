@@ -0,0 +1,138 @@
+package pfsense
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testCredentialsFile = `
+# firewalls managed by this repository
+[default]
+url = https://192.168.1.1
+user = admin
+password = pfsense
+
+[office]
+url              = https://10.0.0.1
+api_client_id    = office_id
+api_client_token = office_token
+`
+
+func writeTestCredentialsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "credentials")
+
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Unable to write credentials file: %v", err)
+	}
+
+	return path
+}
+
+func Test_LoadCredentialsProfile(t *testing.T) {
+	path := writeTestCredentialsFile(t, testCredentialsFile)
+
+	tests := map[string]struct {
+		path     string
+		profile  string
+		expected map[string]string
+		err      bool
+	}{
+		"defaultProfile":  {path: path, expected: map[string]string{"url": "https://192.168.1.1", "user": "admin", "password": "pfsense"}},
+		"namedProfile":    {path: path, profile: "office", expected: map[string]string{"url": "https://10.0.0.1", "api_client_id": "office_id", "api_client_token": "office_token"}},
+		"missingProfile":  {path: path, profile: "home", err: true},
+		"missingFile":     {path: filepath.Join(t.TempDir(), "missing"), err: true},
+		"unknownSetting":  {path: writeTestCredentialsFile(t, "[default]\ntoken = abc\n"), err: true},
+		"invalidLine":     {path: writeTestCredentialsFile(t, "[default]\nurl\n"), err: true},
+		"noDefaultNeeded": {path: writeTestCredentialsFile(t, "[office]\nurl = https://10.0.0.1\n"), expected: map[string]string{}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			values, err := loadCredentialsProfile(test.path, test.profile)
+
+			if test.err {
+				if err == nil {
+					t.Errorf("Expected an error but found %v", values)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			if len(values) != len(test.expected) {
+				t.Errorf("Expected %v but found %v", test.expected, values)
+			}
+
+			for key, value := range test.expected {
+				if values[key] != value {
+					t.Errorf("Expected %s to be %s but found %s", key, value, values[key])
+				}
+			}
+		})
+	}
+}
+
+func Test_ProviderConfigurationSources(t *testing.T) {
+	path := writeTestCredentialsFile(t, testCredentialsFile)
+
+	tests := map[string]struct {
+		env      map[string]string
+		config   map[string]interface{}
+		host     string
+		clientID string
+		user     string
+	}{
+		"credentialsFile": {
+			config: map[string]interface{}{"credentials_file": path},
+			host:   "https://192.168.1.1",
+			user:   "admin",
+		},
+		"profileFromEnvironment": {
+			env:      map[string]string{"PFSENSE_CREDENTIALS_FILE": path, "PFSENSE_PROFILE": "office"},
+			host:     "https://10.0.0.1",
+			clientID: "office_id",
+		},
+		"environmentOverridesFile": {
+			env:      map[string]string{"PFSENSE_URL": "https://172.16.0.1"},
+			config:   map[string]interface{}{"credentials_file": path, "profile": "office"},
+			host:     "https://172.16.0.1",
+			clientID: "office_id",
+		},
+		"configOverridesEnvironment": {
+			env:    map[string]string{"PFSENSE_URL": "https://172.16.0.1", "PFSENSE_USER": "terraform", "PFSENSE_PASSWORD": "secret"},
+			config: map[string]interface{}{"url": "https://172.16.0.2"},
+			host:   "https://172.16.0.2",
+			user:   "terraform",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// keep a credentials file in the home directory out of the test
+			t.Setenv("HOME", t.TempDir())
+
+			for key, value := range test.env {
+				t.Setenv(key, value)
+			}
+
+			d := schema.TestResourceDataRaw(t, Provider().Schema, test.config)
+			client, err := newProviderClient(d)
+
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			cfg := client.Cfg
+
+			if cfg.Host != test.host || cfg.ApiClientID != test.clientID || cfg.User != test.user {
+				t.Errorf("Expected %s with client %s and user %s but found %s with client %s and user %s", test.host, test.clientID, test.user, cfg.Host, cfg.ApiClientID, cfg.User)
+			}
+		})
+	}
+}
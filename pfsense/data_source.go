@@ -0,0 +1,526 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	dataSourceIdProperty     = "id"
+	dataSourceItemsProperty  = "items"
+	dataSourceFilterProperty = "filter"
+)
+
+// computedSchema converts a resource property into a read only data source attribute.
+func computedSchema(s *schema.Schema) *schema.Schema {
+	result := &schema.Schema{
+		Type:        s.Type,
+		Description: s.Description,
+		Sensitive:   s.Sensitive,
+		Computed:    true,
+	}
+
+	switch elem := s.Elem.(type) {
+	case *schema.Schema:
+		result.Elem = &schema.Schema{Type: elem.Type}
+	case *schema.Resource:
+		nested := &schema.Resource{Schema: map[string]*schema.Schema{}}
+
+		for name, nestedSchema := range elem.Schema {
+			nested.Schema[name] = computedSchema(nestedSchema)
+		}
+
+		result.Elem = nested
+	}
+
+	return result
+}
+
+// dataSourceSchema converts a resource property into a data source attribute, primitive attributes can be set to
+// filter the lookup while lists and blocks are only ever read.
+func dataSourceSchema(s *schema.Schema) *schema.Schema {
+	result := computedSchema(s)
+
+	if dataSourceFilterable(s) {
+		result.Optional = true
+		result.ValidateFunc = s.ValidateFunc
+	}
+
+	return result
+}
+
+func dataSourceFilterable(s *schema.Schema) bool {
+	switch s.Type {
+	case schema.TypeString, schema.TypeInt, schema.TypeBool, schema.TypeFloat:
+		return true
+	}
+
+	return false
+}
+
+// dataSourceArgumentSet uses the raw config so that filtering on zero values such as `disabled = false` works.
+func dataSourceArgumentSet(d *schema.ResourceData, name string) bool {
+	config := d.GetRawConfig()
+
+	if config.IsNull() {
+		_, ok := d.GetOk(name)
+		return ok
+	}
+
+	return !config.GetAttr(name).IsNull()
+}
+
+func (r *resource[RequestType, ResponseType, IdType]) matchesDataSourceFilters(d *schema.ResourceData, item *ResponseType) (bool, error) {
+	for name, prop := range r.properties {
+		if prop.getFromResponse == nil || !dataSourceFilterable(prop.schema) || !dataSourceArgumentSet(d, name) {
+			continue
+		}
+
+		value, err := prop.getFromResponse(item)
+
+		if err != nil {
+			return false, err
+		}
+
+		expected := d.Get(name)
+		value = parseValue(value)
+
+		if value == nil {
+			value = reflect.Zero(reflect.TypeOf(expected)).Interface()
+		} else if reflectValue := reflect.ValueOf(value); reflectValue.Kind() == reflect.Pointer {
+			value = reflectValue.Elem().Interface()
+		}
+
+		if fmt.Sprint(value) != fmt.Sprint(expected) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (r *resource[RequestType, ResponseType, IdType]) GetDataSourceReadFunction() schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*apiClient)
+
+		var partition string
+		var id IdType
+		var err error
+
+		configuredId, lookupById := d.GetOk(dataSourceIdProperty)
+
+		if lookupById {
+			d.SetId(configuredId.(string))
+
+			if partition, id, err = r.getResourceId(d); err != nil {
+				return diag.FromErr(err)
+			}
+		} else if r.partitionId != "" {
+			value, ok := d.GetOk(r.partitionId)
+
+			if !ok {
+				return diag.Errorf("Either %s or %s must be set to look up %s", dataSourceIdProperty, r.partitionId, r.name)
+			}
+
+			partition = value.(string)
+		}
+
+		list, err := r.list(ctx, client, partition)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var matches []*ResponseType
+
+		for _, item := range list {
+			match, err := r.matchesDataSourceFilters(d, item)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if !match {
+				continue
+			}
+
+			if lookupById {
+				itemId, err := r.getId(ctx, client, item)
+
+				if err != nil {
+					return diag.Errorf("Unable to get Id from listed value, received err: %v", err)
+				}
+
+				if itemId != id {
+					continue
+				}
+			}
+
+			matches = append(matches, item)
+		}
+
+		if len(matches) == 0 {
+			return diag.Errorf("Unable to find %s matching the given arguments", r.name)
+		} else if len(matches) > 1 {
+			return diag.Errorf("Found %d %s matching the given arguments, add arguments so that only one matches", len(matches), r.name)
+		}
+
+		if err := r.updateResource(d, matches[0]); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if id, err = r.getId(ctx, client, matches[0]); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if r.partitionId != "" {
+			if err := d.Set(r.partitionId, partition); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		d.SetId(r.formatId(partition, id))
+
+		if err := d.Set(dataSourceIdProperty, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+}
+
+// AddDataSource registers a read only data source with the same name and attributes as the resource, which looks up
+// a single existing item by its ID or by any of its attributes.
+func (r *resource[RequestType, ResponseType, IdType]) AddDataSource(provider *schema.Provider) {
+	_, exists := provider.DataSourcesMap[r.name]
+
+	if exists {
+		panic(fmt.Sprintf("Data source %s already exists", r.name))
+	}
+
+	dataSource := &schema.Resource{
+		ReadContext: r.GetDataSourceReadFunction(),
+		Schema: map[string]*schema.Schema{
+			dataSourceIdProperty: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the item to look up, in the same format used to import the resource. If not set, the other arguments must match exactly one item.",
+			},
+		},
+		Description: r.description,
+	}
+
+	for name, property := range r.properties {
+		if property.getFromResponse != nil || property.partition {
+			dataSource.Schema[name] = dataSourceSchema(property.schema)
+		}
+	}
+
+	r.setup()
+
+	provider.DataSourcesMap[r.name] = dataSource
+}
+
+// listFilterable returns the attributes that can be used in the filter blocks of a list data source, which are the
+// primitive attributes and lists of primitives.
+func (r *resource[RequestType, ResponseType, IdType]) listFilterable() []string {
+	var names []string
+
+	for name, property := range r.properties {
+		if property.getFromResponse == nil {
+			continue
+		}
+
+		if elem, ok := property.schema.Elem.(*schema.Schema); dataSourceFilterable(property.schema) || (ok && dataSourceFilterable(elem)) {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// responseStrings converts the value of a property into strings so that it can be compared with filter values.
+func (r *resource[RequestType, ResponseType, IdType]) responseStrings(name string, item *ResponseType) ([]string, error) {
+	property := r.properties[name]
+	value, err := property.getFromResponse(item)
+
+	if err != nil {
+		return nil, err
+	}
+
+	value = parseValue(value)
+
+	if value == nil {
+		switch property.schema.Type {
+		case schema.TypeBool:
+			return []string{"false"}, nil
+		case schema.TypeInt, schema.TypeFloat:
+			return []string{"0"}, nil
+		case schema.TypeString:
+			return []string{""}, nil
+		}
+
+		return []string{}, nil
+	}
+
+	reflectValue := reflect.ValueOf(value)
+
+	switch reflectValue.Kind() {
+	case reflect.Pointer:
+		return []string{fmt.Sprint(reflectValue.Elem().Interface())}, nil
+	case reflect.Slice, reflect.Array:
+		result := make([]string, reflectValue.Len())
+
+		for i := range result {
+			result[i] = fmt.Sprint(reflectValue.Index(i).Interface())
+		}
+
+		return result, nil
+	}
+
+	return []string{fmt.Sprint(value)}, nil
+}
+
+// matchesListFilters checks every filter block against an item, an item matches a filter if any of the values of the
+// attribute equal one of the filter values or match the filter regex.
+func (r *resource[RequestType, ResponseType, IdType]) matchesListFilters(filters []interface{}, item *ResponseType) (bool, error) {
+	for _, filter := range filters {
+		filterMap := filter.(map[string]interface{})
+		name := filterMap["name"].(string)
+
+		values, err := interfaceToStringArray(filterMap["values"])
+
+		if err != nil {
+			return false, err
+		}
+
+		var pattern *regexp.Regexp
+
+		if regex := filterMap["regex"].(string); regex != "" {
+			if pattern, err = regexp.Compile(regex); err != nil {
+				return false, fmt.Errorf("Unable to compile regex %s of filter on %s: %v", regex, name, err)
+			}
+		}
+
+		if len(values) == 0 && pattern == nil {
+			return false, fmt.Errorf("Filter on %s needs either values or regex to be set", name)
+		}
+
+		itemValues, err := r.responseStrings(name, item)
+
+		if err != nil {
+			return false, err
+		}
+
+		if !filterMatches(itemValues, values, pattern) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func filterMatches(itemValues []string, values []string, pattern *regexp.Regexp) bool {
+	for _, itemValue := range itemValues {
+		if pattern != nil && !pattern.MatchString(itemValue) {
+			continue
+		}
+
+		if len(values) == 0 {
+			return true
+		}
+
+		for _, value := range values {
+			if itemValue == value {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (r *resource[RequestType, ResponseType, IdType]) responseToMap(ctx context.Context, client *apiClient, partition string, item *ResponseType) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+
+	for name, property := range r.properties {
+		if property.getFromResponse == nil {
+			continue
+		}
+
+		value, err := property.getFromResponse(item)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if value = parseValue(value); value != nil {
+			result[name] = value
+		}
+	}
+
+	id, err := r.getId(ctx, client, item)
+
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get Id from listed value, received err: %v", err)
+	}
+
+	result[dataSourceIdProperty] = r.formatId(partition, id)
+
+	if r.partitionId != "" {
+		result[r.partitionId] = partition
+	}
+
+	return result, nil
+}
+
+func (r *resource[RequestType, ResponseType, IdType]) GetListDataSourceReadFunction(name string) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*apiClient)
+
+		var partition string
+
+		if r.partitionId != "" {
+			partition = d.Get(r.partitionId).(string)
+		}
+
+		list, err := r.list(ctx, client, partition)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		filters := d.Get(dataSourceFilterProperty).([]interface{})
+		items := []interface{}{}
+
+		for _, item := range list {
+			match, err := r.matchesListFilters(filters, item)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if !match {
+				continue
+			}
+
+			itemMap, err := r.responseToMap(ctx, client, partition, item)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			items = append(items, itemMap)
+		}
+
+		if err := d.Set(dataSourceItemsProperty, items); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if r.partitionId != "" {
+			d.SetId(fmt.Sprintf("%s%s%s", name, idSeparator, partition))
+		} else {
+			d.SetId(name)
+		}
+
+		return nil
+	}
+}
+
+// AddListDataSource registers a read only data source that returns every item that matches the filter blocks, for
+// partitioned resources only the items in the given partition are returned.
+func (r *resource[RequestType, ResponseType, IdType]) AddListDataSource(provider *schema.Provider, name string, description string) {
+	_, exists := provider.DataSourcesMap[name]
+
+	if exists {
+		panic(fmt.Sprintf("Data source %s already exists", name))
+	}
+
+	r.setup()
+
+	itemSchema := map[string]*schema.Schema{
+		dataSourceIdProperty: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the item, in the same format used to import the resource.",
+		},
+	}
+
+	for propertyName, property := range r.properties {
+		if property.getFromResponse != nil || property.partition {
+			itemSchema[propertyName] = computedSchema(property.schema)
+		}
+	}
+
+	filterable := r.listFilterable()
+	quoted := make([]string, len(filterable))
+
+	for i, filterName := range filterable {
+		quoted[i] = fmt.Sprintf("`%s`", filterName)
+	}
+
+	dataSource := &schema.Resource{
+		ReadContext: r.GetListDataSourceReadFunction(name),
+		Schema: map[string]*schema.Schema{
+			dataSourceFilterProperty: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only return items that match all of the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  fmt.Sprintf("Attribute to filter on, one of %s. An item matches if the attribute or, for lists, any of its values matches.", strings.Join(quoted, ", ")),
+							ValidateFunc: validation.StringInSlice(filterable, false),
+						},
+						"regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Regular expression the attribute must match.",
+							ValidateFunc: validation.StringIsValidRegExp,
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Values the attribute must equal one of, booleans are `true` or `false`.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			dataSourceItemsProperty: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Items that match the filters.",
+				Elem: &schema.Resource{
+					Schema: itemSchema,
+				},
+			},
+		},
+		Description: description,
+	}
+
+	if r.partitionId != "" {
+		partitionSchema := dataSourceSchema(r.properties[r.partitionId].schema)
+		partitionSchema.Required = true
+		partitionSchema.Optional = false
+		partitionSchema.Computed = false
+		dataSource.Schema[r.partitionId] = partitionSchema
+	}
+
+	provider.DataSourcesMap[name] = dataSource
+}
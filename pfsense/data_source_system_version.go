@@ -0,0 +1,93 @@
+package pfsense
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSystemVersion() *schema.Resource {
+	return &schema.Resource{
+		Description: "Versions of pfSense and of the API package installed on it.",
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			targets, err := m.(*apiClient).targets(d.Get(targetProperty).(string))
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if len(targets) != 1 {
+				return diag.Errorf("%s must be the name of a single endpoint", targetProperty)
+			}
+
+			version, err := targets[0].version(ctx)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			values := map[string]interface{}{
+				"version":              version.System.Version,
+				"base":                 version.System.Base,
+				"patch":                version.System.Patch,
+				"build_time":           version.System.Buildtime,
+				"api_version":          version.API.CurrentVersion,
+				"api_latest_version":   version.API.LatestVersion,
+				"api_update_available": version.API.UpdateAvailable,
+			}
+
+			for name, value := range values {
+				if err := d.Set(name, value); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+
+			d.SetId(targets[0].Cfg.Host)
+
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			targetProperty: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Endpoint to read the versions of, if not specified the provider's `default_target_endpoint` is used.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "pfSense version, e.g. `2.7.0-RELEASE`.",
+			},
+			"base": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "pfSense version without the release type, e.g. `2.7.0`.",
+			},
+			"patch": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Patch level of the pfSense version.",
+			},
+			"build_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the installed pfSense version was built.",
+			},
+			"api_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the API package installed, e.g. `v1.7.6`. Resources and attributes the installed version doesn't support are reported when planning.",
+			},
+			"api_latest_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Latest version of the API package available.",
+			},
+			"api_update_available": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a newer version of the API package is available.",
+			},
+		},
+	}
+}
@@ -0,0 +1,157 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+func testDataSourceAliases() *resource[pfsenseapi.FirewallAliasRequest, pfsenseapi.FirewallAlias, string] {
+	r := resourceFirewallAlias()
+	r.list = func(_ context.Context, _ *apiClient, _ string) ([]*pfsenseapi.FirewallAlias, error) {
+		return []*pfsenseapi.FirewallAlias{
+			{Name: "web_servers", Type: "host", Address: "10.0.0.1 10.0.0.2", Descr: "Web"},
+			{Name: "db_servers", Type: "host", Address: "10.0.1.1", Descr: "Database"},
+			{Name: "web_ports", Type: "port", Address: "80 443", Descr: "Web"},
+		}, nil
+	}
+
+	return r
+}
+
+func Test_DataSourceLookup(t *testing.T) {
+	tests := map[string]struct {
+		config   map[string]interface{}
+		expected string
+	}{
+		"byId":              {config: map[string]interface{}{"id": "db_servers"}, expected: "db_servers"},
+		"byIdProperty":      {config: map[string]interface{}{"name": "web_ports"}, expected: "web_ports"},
+		"byAttributes":      {config: map[string]interface{}{"description": "Web", "type": "host"}, expected: "web_servers"},
+		"noMatch":           {config: map[string]interface{}{"description": "Mail"}},
+		"ambiguous":         {config: map[string]interface{}{"description": "Web"}},
+		"idWithOtherFilter": {config: map[string]interface{}{"id": "db_servers", "type": "port"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			provider := &schema.Provider{DataSourcesMap: map[string]*schema.Resource{}}
+			r := testDataSourceAliases()
+			r.AddDataSource(provider)
+
+			dataSource := provider.DataSourcesMap[r.name]
+			d := schema.TestResourceDataRaw(t, dataSource.Schema, test.config)
+			diags := dataSource.ReadContext(context.Background(), d, &apiClient{})
+
+			if test.expected == "" {
+				if !diags.HasError() {
+					t.Errorf("Expected an error but found %s", d.Id())
+				}
+
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected error %v", diags)
+			}
+
+			if d.Id() != test.expected || d.Get("name") != test.expected {
+				t.Errorf("Expected %s but found id %s and name %s", test.expected, d.Id(), d.Get("name"))
+			}
+		})
+	}
+}
+
+func Test_DataSourceRequiresPartition(t *testing.T) {
+	provider := &schema.Provider{DataSourcesMap: map[string]*schema.Resource{}}
+	r := resourceDHCPStaticMapping()
+	r.list = func(_ context.Context, _ *apiClient, iface string) ([]*pfsenseapi.DHCPStaticMapping, error) {
+		if iface != "lan" {
+			return []*pfsenseapi.DHCPStaticMapping{}, nil
+		}
+
+		return []*pfsenseapi.DHCPStaticMapping{{Mac: "aa:bb:cc:dd:ee:ff", Hostname: "printer"}}, nil
+	}
+	r.AddDataSource(provider)
+
+	dataSource := provider.DataSourcesMap[r.name]
+
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"host_name": "printer"})
+
+	if diags := dataSource.ReadContext(context.Background(), d, &apiClient{}); !diags.HasError() {
+		t.Errorf("Expected an error when neither id nor interface is set")
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"id": "lan.aa:bb:cc:dd:ee:ff"})
+
+	if diags := dataSource.ReadContext(context.Background(), d, &apiClient{}); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if d.Get("interface") != "lan" || d.Get("host_name") != "printer" {
+		t.Errorf("Expected the static mapping on lan but found %s on %s", d.Get("host_name"), d.Get("interface"))
+	}
+}
+
+func Test_ListDataSourceFilters(t *testing.T) {
+	tests := map[string]struct {
+		filters  []interface{}
+		expected []string
+	}{
+		"noFilters": {expected: []string{"1", "2", "3"}},
+		"byListAttribute": {
+			filters:  []interface{}{map[string]interface{}{"name": "interface", "values": []interface{}{"wan"}}},
+			expected: []string{"1", "3"},
+		},
+		"byRegex": {
+			filters:  []interface{}{map[string]interface{}{"name": "description", "regex": "^Allow"}},
+			expected: []string{"1", "2"},
+		},
+		"byDisabled": {
+			filters:  []interface{}{map[string]interface{}{"name": "disabled", "values": []interface{}{"false"}}},
+			expected: []string{"1", "3"},
+		},
+		"combined": {
+			filters: []interface{}{
+				map[string]interface{}{"name": "description", "regex": "^Allow"},
+				map[string]interface{}{"name": "disabled", "values": []interface{}{"false"}},
+			},
+			expected: []string{"1"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			provider := &schema.Provider{DataSourcesMap: map[string]*schema.Resource{}}
+			r := resourceFirewallRule()
+			r.list = func(_ context.Context, _ *apiClient, _ string) ([]*pfsenseapi.FirewallRule, error) {
+				return []*pfsenseapi.FirewallRule{
+					{Tracker: 1, Interface: "wan", Descr: "Allow HTTPS"},
+					{Tracker: 2, Interface: "lan", Descr: "Allow SSH", Disabled: true},
+					{Tracker: 3, Interface: "lan,wan", Descr: "Block all"},
+				}, nil
+			}
+			r.AddListDataSource(provider, "pfsense_firewall_rules", "Firewall Rules")
+
+			dataSource := provider.DataSourcesMap["pfsense_firewall_rules"]
+			d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"filter": test.filters})
+
+			if diags := dataSource.ReadContext(context.Background(), d, &apiClient{}); diags.HasError() {
+				t.Fatalf("Unexpected error %v", diags)
+			}
+
+			items := d.Get("items").([]interface{})
+			ids := make([]string, len(items))
+
+			for i, item := range items {
+				ids[i] = item.(map[string]interface{})["id"].(string)
+			}
+
+			if fmt.Sprint(ids) != fmt.Sprint(test.expected) {
+				t.Errorf("Expected %v but found %v", test.expected, ids)
+			}
+		})
+	}
+}
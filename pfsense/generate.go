@@ -0,0 +1,424 @@
+package pfsense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// generatedResource is an item found on pfSense, written as an import block and the resource that manages it.
+type generatedResource struct {
+	name     string
+	label    string
+	importId string
+	write    func(*hclwrite.Body)
+}
+
+// generator is implemented by the resources Generate writes.
+type generator interface {
+	generate(ctx context.Context, client *apiClient, partitions []string) ([]*generatedResource, error)
+	resourceName() string
+	AddResource(*schema.Provider)
+}
+
+// generators returns the resources Generate writes, in the order they're written. pfsense_apply and
+// pfsense_config_backup aren't part of the configuration so they're left out.
+func generators() []generator {
+	generators := []generator{
+		resourceInterface(),
+		resourceInterfaceVLAN(),
+		resourceFirewallAlias(),
+		resourceFirewallSchedule(),
+		resourceTrafficShaperLimiter(),
+		resourceTrafficShaperQueue(),
+		resourceRoutingGateway(),
+		resourceRoutingGatewayGroup(),
+		resourceRoutingStaticRoute(),
+		resourceDHCPServer(),
+		resourceDHCPStaticMapping(),
+		resourceUnboundHostOverride(),
+		resourceFirewallRule(),
+		resourceFirewallRuleOrder(),
+		resourceNATOutboundMode(),
+		resourceNATOutboundMapping(),
+		resourceNATOneToOne(),
+		resourceNATPortForward(),
+	}
+
+	provider := &schema.Provider{ResourcesMap: map[string]*schema.Resource{}}
+
+	for _, g := range generators {
+		g.AddResource(provider)
+	}
+
+	return generators
+}
+
+// Generate writes import blocks and resources for the items on pfSense so that an existing firewall can be managed by
+// Terraform. The provider is configured from the PFSENSE_* environment variables and the credentials file, and items
+// are read from the default endpoint. resourceNames limits the resources written, every resource is written when
+// it's empty. Items that can't be listed are reported to log and left out.
+func Generate(ctx context.Context, out io.Writer, log io.Writer, resourceNames []string) error {
+	selected, err := selectGenerators(resourceNames)
+
+	if err != nil {
+		return err
+	}
+
+	provider := Provider()
+
+	diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{}))
+
+	for _, d := range diags {
+		if d.Severity == diag.Warning {
+			fmt.Fprintf(log, "Warning: %s: %s\n", d.Summary, d.Detail)
+			continue
+		}
+
+		if d.Detail != "" {
+			return fmt.Errorf("Unable to configure the provider, %s: %s", d.Summary, d.Detail)
+		}
+
+		return fmt.Errorf("Unable to configure the provider, %s", d.Summary)
+	}
+
+	targets, err := provider.Meta().(*apiClient).targets("")
+
+	if err != nil {
+		return err
+	}
+
+	client := targets[0]
+	interfaces, err := listInterfaces(ctx, client)
+
+	if err != nil {
+		return fmt.Errorf("Unable to list interfaces, %w", err)
+	}
+
+	partitions := make([]string, len(interfaces))
+
+	for i, iface := range interfaces {
+		partitions[i] = iface.Name
+	}
+
+	file := hclwrite.NewEmptyFile()
+	labels := map[string]bool{}
+	count := 0
+
+	for _, g := range selected {
+		resources, err := g.generate(ctx, client, partitions)
+
+		if err != nil {
+			fmt.Fprintf(log, "Warning: %v\n", err)
+		}
+
+		for _, resource := range resources {
+			writeGeneratedResource(file.Body(), resource, labels)
+		}
+
+		count += len(resources)
+	}
+
+	if _, err := out.Write(hclwrite.Format(file.Bytes())); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(log, "Generated %d resources\n", count)
+
+	return nil
+}
+
+func selectGenerators(resourceNames []string) ([]generator, error) {
+	all := generators()
+
+	if len(resourceNames) == 0 {
+		return all, nil
+	}
+
+	byName := map[string]generator{}
+	names := make([]string, len(all))
+
+	for i, g := range all {
+		byName[g.resourceName()] = g
+		names[i] = g.resourceName()
+	}
+
+	selected := make([]generator, len(resourceNames))
+
+	for i, name := range resourceNames {
+		g, ok := byName[name]
+
+		if !ok {
+			return nil, fmt.Errorf("Unable to generate %s, expected one of %s", name, strings.Join(names, ", "))
+		}
+
+		selected[i] = g
+	}
+
+	return selected, nil
+}
+
+func (r *resource[RequestType, ResponseType, IdType]) resourceName() string {
+	return r.name
+}
+
+// generate lists the items of every partition and renders the arguments read from them. Partitioned resources are
+// partitioned by interface, so partitions are the interfaces of the firewall. Items are sorted by their import Id so
+// the output doesn't change between runs when pfSense lists them in a different order.
+func (r *resource[RequestType, ResponseType, IdType]) generate(ctx context.Context, client *apiClient, partitions []string) ([]*generatedResource, error) {
+	if r.partitionId == "" {
+		partitions = []string{""}
+	} else {
+		partitions = append([]string(nil), partitions...)
+		sort.Strings(partitions)
+	}
+
+	var result []*generatedResource
+	var errs []error
+
+	for _, partition := range partitions {
+		list, err := r.list(ctx, client, partition)
+
+		if err != nil {
+			if partition != "" {
+				err = fmt.Errorf("Unable to list %s with %s equal to %s, %w", r.name, r.partitionId, partition, err)
+			} else {
+				err = fmt.Errorf("Unable to list %s, %w", r.name, err)
+			}
+
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, item := range list {
+			resource, err := r.generateResource(ctx, client, partition, item)
+
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			result = append(result, resource)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].importId < result[j].importId
+	})
+
+	return result, errors.Join(errs...)
+}
+
+func (r *resource[RequestType, ResponseType, IdType]) generateResource(ctx context.Context, client *apiClient, partition string, item *ResponseType) (*generatedResource, error) {
+	id, err := r.getId(ctx, client, item)
+
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get Id from listed value, received err: %v", err)
+	}
+
+	d := r.schemaResource.Data(nil)
+
+	if err := r.updateResource(d, item); err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+
+	for name, property := range r.properties {
+		if property.partition {
+			values[name] = partition
+		} else if property.getFromResponse != nil {
+			values[name] = d.Get(name)
+		}
+	}
+
+	// items are named after the first of their import keys that's set, which is usually their description
+	label := fmt.Sprint(id)
+
+	for _, key := range r.importKeys {
+		if keyValue, _ := d.Get(key).(string); keyValue != "" {
+			label = keyValue
+			break
+		}
+	}
+
+	if partition != "" {
+		label = fmt.Sprintf("%s_%s", partition, label)
+	}
+
+	return &generatedResource{
+		name:     r.name,
+		label:    label,
+		importId: r.formatId(partition, id),
+		write: func(body *hclwrite.Body) {
+			writeGeneratedArguments(body, r.schemaResource.Schema, values)
+		},
+	}, nil
+}
+
+var labelInvalidCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// generatedLabel turns a name into a Terraform identifier which isn't in labels yet.
+func generatedLabel(resourceName string, name string, labels map[string]bool) string {
+	label := strings.Trim(labelInvalidCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = strings.Trim(fmt.Sprintf("%s_%s", strings.TrimPrefix(resourceName, "pfsense_"), label), "_")
+	}
+
+	unique := label
+
+	for i := 2; labels[resourceName+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+
+	labels[resourceName+"."+unique] = true
+
+	return unique
+}
+
+func writeGeneratedResource(body *hclwrite.Body, resource *generatedResource, labels map[string]bool) {
+	label := generatedLabel(resource.name, resource.label, labels)
+
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+
+	importBlock := body.AppendNewBlock("import", nil).Body()
+	importBlock.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: resource.name}, hcl.TraverseAttr{Name: label}})
+	importBlock.SetAttributeValue("id", cty.StringVal(resource.importId))
+	body.AppendNewline()
+	resource.write(body.AppendNewBlock("resource", []string{resource.name, label}).Body())
+}
+
+// writeGeneratedArguments writes the arguments that are set and aren't their default, nested resources are written as
+// blocks after the attributes.
+func writeGeneratedArguments(body *hclwrite.Body, schemas map[string]*schema.Schema, values map[string]interface{}) {
+	names := make([]string, 0, len(values))
+
+	for name := range values {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var blocks []string
+
+	for _, name := range names {
+		s, ok := schemas[name]
+
+		if !ok || (!s.Required && !s.Optional) {
+			continue
+		}
+
+		if !s.Required && isDefaultValue(s, values[name]) {
+			continue
+		}
+
+		if _, ok := s.Elem.(*schema.Resource); ok {
+			blocks = append(blocks, name)
+			continue
+		}
+
+		body.SetAttributeValue(name, generatedValue(values[name]))
+	}
+
+	for _, name := range blocks {
+		elem := schemas[name].Elem.(*schema.Resource)
+
+		for _, item := range generatedList(values[name]) {
+			if itemValues, ok := item.(map[string]interface{}); ok {
+				writeGeneratedArguments(body.AppendNewBlock(name, nil).Body(), elem.Schema, itemValues)
+			}
+		}
+	}
+}
+
+// isDefaultValue checks whether leaving an argument out has the same result as the value.
+func isDefaultValue(s *schema.Schema, value interface{}) bool {
+	if s.Default != nil {
+		return reflect.DeepEqual(s.Default, value)
+	}
+
+	if value == nil {
+		return true
+	}
+
+	if set, ok := value.(*schema.Set); ok {
+		return set.Len() == 0
+	}
+
+	reflectValue := reflect.ValueOf(value)
+
+	switch reflectValue.Kind() {
+	case reflect.Slice, reflect.Map:
+		return reflectValue.Len() == 0
+	}
+
+	return reflectValue.IsZero()
+}
+
+func generatedList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+
+	return nil
+}
+
+// generatedValue converts a value read from schema.ResourceData into its HCL value.
+func generatedValue(value interface{}) cty.Value {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case float64:
+		return cty.NumberFloatVal(v)
+	case *schema.Set, []interface{}:
+		list := generatedList(v)
+
+		if len(list) == 0 {
+			return cty.EmptyTupleVal
+		}
+
+		values := make([]cty.Value, len(list))
+
+		for i, item := range list {
+			values[i] = generatedValue(item)
+		}
+
+		return cty.TupleVal(values)
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return cty.EmptyObjectVal
+		}
+
+		values := map[string]cty.Value{}
+
+		for key, item := range v {
+			values[key] = generatedValue(item)
+		}
+
+		return cty.ObjectVal(values)
+	}
+
+	return cty.StringVal(fmt.Sprint(value))
+}
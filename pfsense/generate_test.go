@@ -0,0 +1,202 @@
+package pfsense
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+func testGenerate(t *testing.T, g generator, partitions []string) string {
+	resources, err := g.generate(context.Background(), &apiClient{}, partitions)
+
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	file := hclwrite.NewEmptyFile()
+	labels := map[string]bool{}
+
+	for _, resource := range resources {
+		writeGeneratedResource(file.Body(), resource, labels)
+	}
+
+	return string(hclwrite.Format(file.Bytes()))
+}
+
+func Test_GeneratePartitionedResource(t *testing.T) {
+	r := testImportResource([]*testImportItem{
+		{Partition: "lan", Name: "printer", Description: "Printer"},
+		{Partition: "lan", Name: "a/b"},
+		{Partition: "opt1", Name: "printer", Description: "Printer"},
+		{Partition: "opt1", Name: "copier", Description: "Printer"},
+	})
+
+	expected := `import {
+  to = pfsense_test_import_item.lan_a_b
+  id = "lan/a%2Fb"
+}
+
+resource "pfsense_test_import_item" "lan_a_b" {
+  interface = "lan"
+  name      = "a/b"
+}
+
+import {
+  to = pfsense_test_import_item.lan_printer
+  id = "lan/printer"
+}
+
+resource "pfsense_test_import_item" "lan_printer" {
+  description = "Printer"
+  interface   = "lan"
+  name        = "printer"
+}
+
+import {
+  to = pfsense_test_import_item.opt1_printer
+  id = "opt1/copier"
+}
+
+resource "pfsense_test_import_item" "opt1_printer" {
+  description = "Printer"
+  interface   = "opt1"
+  name        = "copier"
+}
+
+import {
+  to = pfsense_test_import_item.opt1_printer_2
+  id = "opt1/printer"
+}
+
+resource "pfsense_test_import_item" "opt1_printer_2" {
+  description = "Printer"
+  interface   = "opt1"
+  name        = "printer"
+}
+`
+
+	if generated := testGenerate(t, r, []string{"lan", "opt1"}); generated != expected {
+		t.Errorf("Expected\n%s\nbut generated\n%s", expected, generated)
+	}
+}
+
+func Test_GenerateIsStable(t *testing.T) {
+	items := []*testImportItem{
+		{Partition: "opt1", Name: "printer", Description: "Printer"},
+		{Partition: "lan", Name: "printer", Description: "Printer"},
+		{Partition: "opt1", Name: "copier", Description: "Printer"},
+		{Partition: "lan", Name: "scanner"},
+	}
+
+	expected := testGenerate(t, testImportResource(items), []string{"lan", "opt1"})
+
+	reversed := make([]*testImportItem, len(items))
+
+	for i, item := range items {
+		reversed[len(items)-1-i] = item
+	}
+
+	if generated := testGenerate(t, testImportResource(reversed), []string{"opt1", "lan"}); generated != expected {
+		t.Errorf("Expected\n%s\nbut generated\n%s", expected, generated)
+	}
+}
+
+func Test_GenerateNestedBlocks(t *testing.T) {
+	r := resourceFirewallAlias()
+	r.AddResource(&schema.Provider{ResourcesMap: map[string]*schema.Resource{}})
+	r.list = func(_ context.Context, _ *apiClient, _ string) ([]*pfsenseapi.FirewallAlias, error) {
+		return []*pfsenseapi.FirewallAlias{{
+			Name:    "web_servers",
+			Type:    "host",
+			Address: "10.0.0.1 10.0.0.2",
+			Detail:  "web ${1}||",
+		}}, nil
+	}
+
+	expected := `import {
+  to = pfsense_firewall_alias.web_servers
+  id = "web_servers"
+}
+
+resource "pfsense_firewall_alias" "web_servers" {
+  name = "web_servers"
+  type = "host"
+  target {
+    address     = "10.0.0.1"
+    description = "web $${1}"
+  }
+  target {
+    address = "10.0.0.2"
+  }
+}
+`
+
+	if generated := testGenerate(t, r, nil); generated != expected {
+		t.Errorf("Expected\n%s\nbut generated\n%s", expected, generated)
+	}
+}
+
+func Test_GenerateReportsListErrors(t *testing.T) {
+	r := testImportResource(nil)
+	listErr := errors.New("No DHCP server")
+	r.list = func(_ context.Context, _ *apiClient, partition string) ([]*testImportItem, error) {
+		if partition == "wan" {
+			return nil, listErr
+		}
+
+		return []*testImportItem{{Partition: partition, Name: "printer"}}, nil
+	}
+
+	resources, err := r.generate(context.Background(), &apiClient{}, []string{"wan", "lan"})
+
+	if !errors.Is(err, listErr) || !strings.Contains(err.Error(), "interface equal to wan") {
+		t.Errorf("Expected the error listing wan but got %v", err)
+	}
+
+	if len(resources) != 1 || resources[0].importId != "lan/printer" {
+		t.Errorf("Expected the items of lan to be generated but got %v", resources)
+	}
+}
+
+func Test_GeneratedLabel(t *testing.T) {
+	labels := map[string]bool{}
+
+	tests := []struct {
+		resourceName string
+		name         string
+		expected     string
+	}{
+		{resourceName: "pfsense_firewall_rule", name: "Allow HTTPS", expected: "allow_https"},
+		{resourceName: "pfsense_firewall_rule", name: "Allow  HTTPS!", expected: "allow_https_2"},
+		{resourceName: "pfsense_firewall_alias", name: "allow_https", expected: "allow_https"},
+		{resourceName: "pfsense_firewall_rule", name: "1690000000", expected: "firewall_rule_1690000000"},
+		{resourceName: "pfsense_nat_port_forward", name: "!!", expected: "nat_port_forward"},
+	}
+
+	for _, test := range tests {
+		if label := generatedLabel(test.resourceName, test.name, labels); label != test.expected {
+			t.Errorf("Expected %s for %s but got %s", test.expected, test.name, label)
+		}
+	}
+}
+
+func Test_SelectGenerators(t *testing.T) {
+	selected, err := selectGenerators([]string{"pfsense_firewall_rule", "pfsense_firewall_alias"})
+
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if len(selected) != 2 || selected[0].resourceName() != "pfsense_firewall_rule" || selected[1].resourceName() != "pfsense_firewall_alias" {
+		t.Errorf("Unexpected generators %v", selected)
+	}
+
+	if _, err := selectGenerators([]string{"pfsense_apply"}); err == nil {
+		t.Errorf("Expected an error generating pfsense_apply")
+	}
+}
@@ -0,0 +1,210 @@
+package pfsense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// partitionSeparator separates the partition from the ID of partitioned resources, e.g. lan/aa:bb:cc:dd:ee:ff. "%" and
+// "/" are escaped as "%25" and "%2F" in both parts so that either of them can contain the separator.
+const partitionSeparator = "/"
+
+// importKeySeparator separates a property from its value when an item is imported by one of its importKeys, e.g.
+// description=Allow HTTPS.
+const importKeySeparator = "="
+
+var errInvalidId = errors.New("Invalid ID")
+
+var idPartEscaper = strings.NewReplacer("%", "%25", partitionSeparator, "%2F")
+var idPartUnescaper = strings.NewReplacer("%25", "%", "%2F", partitionSeparator, "%2f", partitionSeparator)
+
+func escapeIdPart(value string) string {
+	return idPartEscaper.Replace(value)
+}
+
+func unescapeIdPart(value string) string {
+	return idPartUnescaper.Replace(value)
+}
+
+// splitPartition splits the ID of a partitioned resource into its partition and ID. IDs without a partitionSeparator
+// were created by earlier versions of the provider and are split on the first idSeparator instead, read rewrites them.
+func splitPartition(id string) (string, string) {
+	if partition, value, ok := strings.Cut(id, partitionSeparator); ok {
+		return unescapeIdPart(partition), unescapeIdPart(value)
+	}
+
+	partition, value, _ := strings.Cut(id, idSeparator)
+
+	return partition, value
+}
+
+func parseIdValue[IdType ~string | ~int](id string) (IdType, error) {
+	var example interface{} = new(IdType)
+	var zeroValue IdType
+
+	switch example.(type) {
+	case *int:
+		number, err := strconv.Atoi(id)
+
+		if err != nil {
+			return zeroValue, fmt.Errorf("%w %q, it should be a number", errInvalidId, id)
+		}
+
+		return any(number).(IdType), nil
+	case *string:
+		return any(id).(IdType), nil
+	}
+
+	return zeroValue, fmt.Errorf("Unable to determine type of example %v", example)
+}
+
+// importFormat describes the IDs terraform import accepts for the resource.
+func (r *resource[RequestType, ResponseType, IdType]) importFormat() string {
+	idName := "id"
+
+	for name, property := range r.properties {
+		if property.idProperty {
+			idName = name
+		}
+	}
+
+	formats := []string{fmt.Sprintf("<%s>", idName)}
+
+	for _, key := range r.importKeys {
+		formats = append(formats, fmt.Sprintf("%s%s<%s>", key, importKeySeparator, key))
+	}
+
+	if r.partitionId != "" {
+		for i, format := range formats {
+			formats[i] = fmt.Sprintf("<%s>%s%s", r.partitionId, partitionSeparator, format)
+		}
+	}
+
+	return strings.Join(formats, " or ")
+}
+
+// parseImportId validates an ID given to terraform import and finds the ID of the item it refers to, items can be
+// imported by their ID or by the value of one of the resource's importKeys.
+func (r *resource[RequestType, ResponseType, IdType]) parseImportId(ctx context.Context, client *apiClient, importId string) (string, IdType, error) {
+	var partition string
+	var zeroValue IdType
+	value := importId
+
+	if r.partitionId != "" {
+		var ok bool
+
+		if partition, value, ok = strings.Cut(importId, partitionSeparator); !ok {
+			return "", zeroValue, fmt.Errorf("Unable to import %s with ID %q, the %s is missing. Expected %s", r.name, importId, r.partitionId, r.importFormat())
+		}
+
+		if partition == "" {
+			return "", zeroValue, fmt.Errorf("Unable to import %s with ID %q, the %s is empty. Expected %s", r.name, importId, r.partitionId, r.importFormat())
+		}
+
+		partition = unescapeIdPart(partition)
+		value = unescapeIdPart(value)
+	}
+
+	if value == "" {
+		return "", zeroValue, fmt.Errorf("Unable to import %s with ID %q, the ID is empty. Expected %s", r.name, importId, r.importFormat())
+	}
+
+	if key, keyValue, ok := strings.Cut(value, importKeySeparator); ok && slices.Contains(r.importKeys, key) {
+		id, err := r.findImportKey(ctx, client, partition, key, keyValue)
+		return partition, id, err
+	}
+
+	id, err := parseIdValue[IdType](value)
+
+	if err != nil {
+		return "", zeroValue, fmt.Errorf("Unable to import %s with ID %q, %w. Expected %s", r.name, importId, err, r.importFormat())
+	}
+
+	return partition, id, nil
+}
+
+// findImportKey finds the ID of the only item whose property key has the value.
+func (r *resource[RequestType, ResponseType, IdType]) findImportKey(ctx context.Context, client *apiClient, partition string, key string, value string) (IdType, error) {
+	var zeroValue IdType
+
+	list, err := r.cachedList(ctx, client, partition)
+
+	if err != nil {
+		return zeroValue, err
+	}
+
+	var ids []IdType
+
+	for _, item := range list {
+		values, err := r.responseStrings(key, item)
+
+		if err != nil {
+			return zeroValue, err
+		}
+
+		if !slices.Contains(values, value) {
+			continue
+		}
+
+		id, err := r.getId(ctx, client, item)
+
+		if err != nil {
+			return zeroValue, fmt.Errorf("Unable to get Id from listed value, received err: %v", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	var partitionErrorText string
+
+	if r.partitionId != "" {
+		partitionErrorText = fmt.Sprintf(" and with %s equal to %s", r.partitionId, partition)
+	}
+
+	switch len(ids) {
+	case 0:
+		return zeroValue, fmt.Errorf("%w with %s equal to %q%s", errNotFound, key, value, partitionErrorText)
+	case 1:
+		return ids[0], nil
+	}
+
+	formatted := make([]string, len(ids))
+
+	for i, id := range ids {
+		formatted[i] = r.formatId(partition, id)
+	}
+
+	return zeroValue, fmt.Errorf("Unable to import %s, %d items have %s equal to %q%s. Import one of them by ID instead: %s", r.name, len(ids), key, value, partitionErrorText, strings.Join(formatted, ", "))
+}
+
+func (r *resource[RequestType, ResponseType, IdType]) GetImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			targets, err := r.targets(d, m)
+
+			if err != nil {
+				return nil, err
+			}
+
+			partition, id, err := r.parseImportId(ctx, targets[0], d.Id())
+
+			if err != nil {
+				return nil, err
+			}
+
+			d.SetId(r.formatId(partition, id))
+
+			if err := r.read(ctx, targets[0], d, false); err != nil {
+				return nil, err
+			}
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
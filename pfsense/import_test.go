@@ -0,0 +1,234 @@
+package pfsense
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+type testImportItem struct {
+	Partition   string
+	Name        string
+	Description string
+}
+
+// testImportResource is a partitioned resource listing items, items are identified by their name.
+func testImportResource(items []*testImportItem) *resource[testImportItem, testImportItem, string] {
+	r := &resource[testImportItem, testImportItem, string]{
+		name:        "pfsense_test_import_item",
+		description: "Test item",
+		importKeys:  []string{"description"},
+		list: func(_ context.Context, _ *apiClient, partition string) ([]*testImportItem, error) {
+			var list []*testImportItem
+
+			for _, item := range items {
+				if item.Partition == partition {
+					list = append(list, item)
+				}
+			}
+
+			return list, nil
+		},
+		properties: map[string]*resourceProperty[testImportItem, testImportItem]{
+			"interface": {
+				partition: true,
+				schema:    &schema.Schema{Type: schema.TypeString, Required: true, ForceNew: true, Description: "Interface"},
+			},
+			"name": {
+				idProperty: true,
+				schema:     &schema.Schema{Type: schema.TypeString, Required: true, ForceNew: true, Description: "Name"},
+				getFromResponse: func(res *testImportItem) (interface{}, error) {
+					return res.Name, nil
+				},
+			},
+			"description": {
+				schema: &schema.Schema{Type: schema.TypeString, Optional: true, Description: "Description"},
+				getFromResponse: func(res *testImportItem) (interface{}, error) {
+					return res.Description, nil
+				},
+			},
+		},
+	}
+
+	provider := &schema.Provider{ResourcesMap: map[string]*schema.Resource{}}
+	r.AddResource(provider)
+
+	return r
+}
+
+func Test_FormatIdEscapesParts(t *testing.T) {
+	r := testImportResource(nil)
+
+	tests := map[string]struct {
+		partition string
+		id        string
+		expected  string
+	}{
+		"plain":     {partition: "lan", id: "aa:bb:cc:dd:ee:ff", expected: "lan/aa:bb:cc:dd:ee:ff"},
+		"separator": {partition: "lan", id: "10.0.0.0/24", expected: "lan/10.0.0.0%2F24"},
+		"escape":    {partition: "opt%1", id: "50%", expected: "opt%251/50%25"},
+		"dots":      {partition: "igb0.10", id: "web.server", expected: "igb0.10/web.server"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			id := r.formatId(test.partition, test.id)
+
+			if id != test.expected {
+				t.Fatalf("Expected %s but got %s", test.expected, id)
+			}
+
+			partition, value, err := r.parseResourceId(id)
+
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			if partition != test.partition || value != test.id {
+				t.Errorf("Expected %s and %s but parsed %s and %s", test.partition, test.id, partition, value)
+			}
+		})
+	}
+}
+
+func Test_ParseResourceIdAcceptsLegacyIds(t *testing.T) {
+	r := testImportResource(nil)
+
+	partition, id, err := r.parseResourceId("lan.aa:bb:cc:dd:ee:ff")
+
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if partition != "lan" || id != "aa:bb:cc:dd:ee:ff" {
+		t.Errorf("Expected lan and aa:bb:cc:dd:ee:ff but parsed %s and %s", partition, id)
+	}
+}
+
+func Test_LegacyIdIsRewrittenWhenRead(t *testing.T) {
+	r := testImportResource([]*testImportItem{{Partition: "lan", Name: "printer"}})
+
+	tests := map[string]struct {
+		client      *apiClient
+		endpointIds map[string]string
+	}{
+		"id":          {client: &apiClient{}},
+		"endpointIds": {client: &apiClient{name: "primary"}, endpointIds: map[string]string{"primary": "lan.printer"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			attributes := map[string]string{"id": "lan.printer", "interface": "lan", "name": "printer"}
+
+			for endpoint, id := range test.endpointIds {
+				attributes[targetIdsProperty+".%"] = "1"
+				attributes[targetIdsProperty+"."+endpoint] = id
+			}
+
+			d := r.schemaResource.Data(&terraform.InstanceState{ID: "lan.printer", Attributes: attributes})
+
+			if diags := r.schemaResource.ReadContext(context.Background(), d, test.client); diags.HasError() {
+				t.Fatalf("Unexpected error %v", diags)
+			}
+
+			if d.Id() != "lan/printer" {
+				t.Errorf("Expected the ID to be rewritten to lan/printer but found %s", d.Id())
+			}
+
+			for endpoint := range test.endpointIds {
+				if id := d.Get(targetIdsProperty).(map[string]interface{})[endpoint]; id != "lan/printer" {
+					t.Errorf("Expected the ID on %s to be rewritten to lan/printer but found %v", endpoint, id)
+				}
+			}
+		})
+	}
+}
+
+func Test_Import(t *testing.T) {
+	items := []*testImportItem{
+		{Partition: "lan", Name: "printer", Description: "Printer"},
+		{Partition: "lan", Name: "nas", Description: "Storage"},
+		{Partition: "lan", Name: "backup", Description: "Storage"},
+		{Partition: "opt1", Name: "printer", Description: "Office printer"},
+		{Partition: "lan", Name: "a/b", Description: "Escaped"},
+	}
+
+	tests := map[string]struct {
+		importId   string
+		expectedId string
+		err        string
+		notFound   bool
+	}{
+		"id":                 {importId: "lan/printer", expectedId: "lan/printer"},
+		"otherPartition":     {importId: "opt1/printer", expectedId: "opt1/printer"},
+		"escapedId":          {importId: "lan/a%2Fb", expectedId: "lan/a%2Fb"},
+		"importKey":          {importId: "lan/description=Printer", expectedId: "lan/printer"},
+		"importKeyPartition": {importId: "opt1/description=Office printer", expectedId: "opt1/printer"},
+		"missingPartition":   {importId: "printer", err: "the interface is missing. Expected <interface>/<name> or <interface>/description=<description>"},
+		"emptyPartition":     {importId: "/printer", err: "the interface is empty"},
+		"emptyId":            {importId: "lan/", err: "the ID is empty"},
+		"notFound":           {importId: "lan/scanner", notFound: true},
+		"importKeyNotFound":  {importId: "lan/description=Scanner", notFound: true},
+		"importKeyAmbiguous": {importId: "lan/description=Storage", err: "2 items have description equal to \"Storage\" and with interface equal to lan. Import one of them by ID instead: lan/nas, lan/backup"},
+		"unknownImportKey":   {importId: "lan/name=printer", notFound: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := testImportResource(items)
+			d := r.schemaResource.TestResourceData()
+			d.SetId(test.importId)
+
+			result, err := r.schemaResource.Importer.StateContext(context.Background(), d, &apiClient{})
+
+			if test.err != "" || test.notFound {
+				if err == nil {
+					t.Fatalf("Expected an error but imported %s", d.Id())
+				}
+
+				if test.notFound && !errors.Is(err, errNotFound) {
+					t.Errorf("Expected a not found error but got %v", err)
+				}
+
+				if !strings.Contains(err.Error(), test.err) {
+					t.Errorf("Expected error containing %q but got %q", test.err, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			if len(result) != 1 || result[0].Id() != test.expectedId {
+				t.Fatalf("Expected ID %s but got %s", test.expectedId, d.Id())
+			}
+
+			partition, id, _ := r.parseResourceId(test.expectedId)
+
+			if d.Get("interface") != partition || d.Get("name") != id {
+				t.Errorf("Expected interface %s and name %s but got %v and %v", partition, id, d.Get("interface"), d.Get("name"))
+			}
+		})
+	}
+}
+
+func Test_ImportValidatesIdType(t *testing.T) {
+	r := resourceFirewallRule()
+	r.setup()
+
+	_, _, err := r.parseImportId(context.Background(), &apiClient{}, "https")
+
+	if err == nil {
+		t.Fatalf("Expected an error importing a rule with a tracker that isn't a number")
+	}
+
+	if !errors.Is(err, errInvalidId) || !strings.Contains(err.Error(), "Expected <id> or description=<description>") {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// listCacheEntry is the result of a list call, done is closed once it's available so that concurrent reads of the
+// same list wait for the one call.
+type listCacheEntry struct {
+	done    chan struct{}
+	items   interface{}
+	err     error
+	expires time.Time
+}
+
+func listCacheKey(resourceName string, partition string) string {
+	return fmt.Sprintf("%s%s%s", resourceName, idSeparator, partition)
+}
+
+// cachedList lists the items of a resource once per read_cache_ttl, resources of the same type share the list instead
+// of each downloading it when they are read. Every read lists the items itself when there is no TTL.
+func (c *apiClient) cachedList(ctx context.Context, key string, list func() (interface{}, error)) (interface{}, error) {
+	if c.listCacheTTL <= 0 {
+		return list()
+	}
+
+	c.listCacheLock.Lock()
+
+	if c.listCache == nil {
+		c.listCache = map[string]*listCacheEntry{}
+	}
+
+	entry, cached := c.listCache[key]
+
+	if cached && time.Now().After(entry.expires) {
+		cached = false
+	}
+
+	if !cached {
+		entry = &listCacheEntry{done: make(chan struct{}), expires: time.Now().Add(c.listCacheTTL)}
+		c.listCache[key] = entry
+	}
+
+	c.listCacheLock.Unlock()
+
+	if !cached {
+		entry.items, entry.err = list()
+
+		// errors aren't kept so that the next read tries again
+		if entry.err != nil {
+			c.listCacheLock.Lock()
+
+			if c.listCache[key] == entry {
+				delete(c.listCache, key)
+			}
+
+			c.listCacheLock.Unlock()
+		}
+
+		close(entry.done)
+	}
+
+	select {
+	case <-entry.done:
+		return entry.items, entry.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// invalidateLists forgets every list of a resource, e.g. after one of its items was changed.
+func (c *apiClient) invalidateLists(resourceName string) {
+	c.listCacheLock.Lock()
+	defer c.listCacheLock.Unlock()
+
+	for key := range c.listCache {
+		if strings.HasPrefix(key, resourceName+idSeparator) {
+			delete(c.listCache, key)
+		}
+	}
+}
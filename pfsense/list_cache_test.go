@@ -0,0 +1,193 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+func Test_ListIsSharedByResourcesOfTheSameType(t *testing.T) {
+	var calls int32
+
+	r := resourceFirewallAlias()
+	r.list = func(_ context.Context, _ *apiClient, _ string) ([]*pfsenseapi.FirewallAlias, error) {
+		atomic.AddInt32(&calls, 1)
+
+		return []*pfsenseapi.FirewallAlias{{Name: "web", Type: "host"}, {Name: "db", Type: "host"}}, nil
+	}
+	r.update = func(_ context.Context, _ *apiClient, _ string, _ *pfsenseapi.FirewallAliasRequest) (*pfsenseapi.FirewallAlias, error) {
+		return &pfsenseapi.FirewallAlias{Name: "web", Type: "host"}, nil
+	}
+
+	provider := &schema.Provider{ResourcesMap: map[string]*schema.Resource{}}
+	r.AddResource(provider)
+	resource := provider.ResourcesMap[r.name]
+	client := &apiClient{listCacheTTL: time.Hour}
+
+	read := func() {
+		var wg sync.WaitGroup
+
+		for _, name := range []string{"web", "db", "web", "db"} {
+			wg.Add(1)
+
+			go func(name string) {
+				defer wg.Done()
+
+				d := resource.TestResourceData()
+				d.SetId(name)
+
+				if diags := resource.ReadContext(context.Background(), d, client); diags.HasError() {
+					t.Errorf("Unexpected error %v", diags)
+				}
+			}(name)
+		}
+
+		wg.Wait()
+	}
+
+	read()
+
+	if calls != 1 {
+		t.Errorf("Expected one list call to be shared by every read but found %d", calls)
+	}
+
+	read()
+
+	if calls != 1 {
+		t.Errorf("Expected the list to be kept within the TTL but found %d calls", calls)
+	}
+
+	d := resource.TestResourceData()
+	d.SetId("web")
+	d.Set("name", "web")
+	d.Set("type", "host")
+
+	if diags := resource.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	read()
+
+	if calls != 2 {
+		t.Errorf("Expected the list to be downloaded again after a change but found %d calls", calls)
+	}
+}
+
+func Test_ListErrorsAreNotCached(t *testing.T) {
+	client := &apiClient{listCacheTTL: time.Hour}
+	calls := 0
+
+	list := func() (interface{}, error) {
+		calls++
+
+		if calls == 1 {
+			return nil, fmt.Errorf("pfSense is busy")
+		}
+
+		return []string{"web"}, nil
+	}
+
+	if _, err := client.cachedList(context.Background(), listCacheKey("pfsense_test", ""), list); err == nil {
+		t.Errorf("Expected the first list to fail")
+	}
+
+	if items, err := client.cachedList(context.Background(), listCacheKey("pfsense_test", ""), list); err != nil || fmt.Sprint(items) != "[web]" {
+		t.Errorf("Expected the list to be retried but found %v and %v", items, err)
+	}
+}
+
+func Test_GetIsUsedInsteadOfList(t *testing.T) {
+	r := resourceFirewallRule()
+	r.list = func(_ context.Context, _ *apiClient, _ string) ([]*pfsenseapi.FirewallRule, error) {
+		t.Errorf("The list shouldn't be downloaded when a rule can be fetched directly")
+		return nil, nil
+	}
+	r.get = func(_ context.Context, _ *apiClient, _ string, id int) (*pfsenseapi.FirewallRule, error) {
+		return &pfsenseapi.FirewallRule{Tracker: pfsenseapi.JSONInt(id), Interface: "lan", Descr: "Allow HTTPS"}, nil
+	}
+
+	provider := &schema.Provider{ResourcesMap: map[string]*schema.Resource{}}
+	r.AddResource(provider)
+	resource := provider.ResourcesMap[r.name]
+
+	d := resource.TestResourceData()
+	d.SetId("1700000000")
+
+	if diags := resource.ReadContext(context.Background(), d, &apiClient{}); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if d.Get("description") != "Allow HTTPS" {
+		t.Errorf("Expected the rule to be read but found %s", d.Get("description"))
+	}
+}
+
+func Test_ListCacheExpires(t *testing.T) {
+	tests := map[string]struct {
+		client   *apiClient
+		wait     time.Duration
+		expected int
+	}{
+		"withinTTL": {client: &apiClient{listCacheTTL: time.Hour}, expected: 1},
+		"expired":   {client: &apiClient{listCacheTTL: time.Millisecond}, wait: 5 * time.Millisecond, expected: 2},
+		"disabled":  {client: &apiClient{}, expected: 2},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			list := func() (interface{}, error) {
+				calls++
+				return []string{"web"}, nil
+			}
+
+			for i := 0; i < 2; i++ {
+				if _, err := test.client.cachedList(context.Background(), listCacheKey("pfsense_test", "lan"), list); err != nil {
+					t.Fatalf("Unexpected error %v", err)
+				}
+
+				time.Sleep(test.wait)
+			}
+
+			if calls != test.expected {
+				t.Errorf("Expected %d list calls but found %d", test.expected, calls)
+			}
+		})
+	}
+}
+
+func Test_ListsAreInvalidatedByType(t *testing.T) {
+	client := &apiClient{listCacheTTL: time.Hour}
+	calls := map[string]int{}
+
+	list := func(key string) func() (interface{}, error) {
+		return func() (interface{}, error) {
+			calls[key]++
+			return []string{}, nil
+		}
+	}
+
+	keys := []string{listCacheKey("pfsense_firewall_alias", ""), listCacheKey("pfsense_dhcp_static_mapping", "lan"), listCacheKey("pfsense_dhcp_static_mapping", "opt1")}
+
+	for _, key := range keys {
+		client.cachedList(context.Background(), key, list(key))
+	}
+
+	client.invalidateLists("pfsense_dhcp_static_mapping")
+
+	for _, key := range keys {
+		client.cachedList(context.Background(), key, list(key))
+	}
+
+	expected := map[string]int{keys[0]: 1, keys[1]: 2, keys[2]: 2}
+
+	if fmt.Sprint(calls) != fmt.Sprint(expected) {
+		t.Errorf("Expected list calls %v but found %v", expected, calls)
+	}
+}
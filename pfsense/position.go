@@ -0,0 +1,114 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// locate finds an item of a resource with positionKeys in a list. The item at the position in the state is used when
+// it still has the positionKeys of the state, otherwise items before it were added or removed and the only item that
+// has them is used instead. No item is returned when none of them has.
+func (r *resource[RequestType, ResponseType, IdType]) locate(ctx context.Context, client *apiClient, d *schema.ResourceData, id IdType, list []*ResponseType) (*ResponseType, IdType, error) {
+	var zeroValue IdType
+	var matches []*ResponseType
+	var matchIds []string
+
+	for _, item := range list {
+		itemId, err := r.getId(ctx, client, item)
+
+		if err != nil {
+			return nil, zeroValue, fmt.Errorf("Unable to get Id from listed value, received err: %v", err)
+		}
+
+		matched, err := r.matchesPosition(d, item)
+
+		if err != nil {
+			return nil, zeroValue, err
+		}
+
+		if !matched {
+			continue
+		}
+
+		if itemId == id {
+			return item, id, nil
+		}
+
+		matches = append(matches, item)
+		matchIds = append(matchIds, fmt.Sprint(itemId))
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, zeroValue, nil
+	case 1:
+		newId, err := r.getId(ctx, client, matches[0])
+		return matches[0], newId, err
+	}
+
+	return nil, zeroValue, fmt.Errorf("Unable to find %s with Id %s, it has moved and the items at %s all match its %s. Remove it from the state and import the right one", r.name, fmt.Sprint(id), strings.Join(matchIds, ", "), strings.Join(r.positionKeys, ", "))
+}
+
+// matchesPosition checks whether an item has the positionKeys of the state. The values before any planned change are
+// compared, which are the values on pfSense when the item was last read.
+func (r *resource[RequestType, ResponseType, IdType]) matchesPosition(d *schema.ResourceData, item *ResponseType) (bool, error) {
+	for _, key := range r.positionKeys {
+		property := r.properties[key]
+		value, err := property.getFromResponse(item)
+
+		if err != nil {
+			return false, err
+		}
+
+		// missing values are read into the state as the default, like updateResource does
+		if value = parseValue(value); value == nil {
+			value = property.schema.Default
+		}
+
+		if value == nil {
+			value = ""
+		}
+
+		if prior, _ := d.GetChange(key); fmt.Sprint(value) != fmt.Sprint(prior) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// locateForWrite lists the items of a resource with positionKeys again before changing one, as the list may have
+// changed since it was read. Positions can't change until the write finishes as writes to indexed resources hold
+// the client's indexLock.
+func (r *resource[RequestType, ResponseType, IdType]) locateForWrite(ctx context.Context, client *apiClient, d *schema.ResourceData, partition string, id IdType) (IdType, bool, error) {
+	list, err := r.list(ctx, client, partition)
+
+	if err != nil {
+		return id, false, err
+	}
+
+	item, newId, err := r.locate(ctx, client, d, id, list)
+
+	if err != nil || item == nil {
+		return id, false, err
+	}
+
+	return newId, true, nil
+}
+
+// setEndpointId records that the resource has a new ID on an endpoint.
+func setEndpointId(d *schema.ResourceData, client *apiClient, id string) {
+	previous, _ := endpointId(d, client)
+
+	if ids, ok := d.Get(targetIdsProperty).(map[string]interface{}); ok && ids[client.name] != nil {
+		ids[client.name] = id
+		d.Set(targetIdsProperty, ids)
+	}
+
+	if d.Id() == previous {
+		d.SetId(id)
+	}
+}
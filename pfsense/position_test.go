@@ -0,0 +1,205 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+// testPortForwards fakes the list of port forwards, which pfSense identifies by their position.
+type testPortForwards struct {
+	items []*natPortForward
+}
+
+func testPortForward(port string, description string) *natPortForward {
+	return &natPortForward{
+		Interface:   "wan",
+		Protocol:    "tcp",
+		Destination: &pfsenseapi.FirewallTarget{Network: "wanip", Port: port},
+		Target:      "10.0.0.2",
+		Descr:       description,
+	}
+}
+
+func (f *testPortForwards) resource() *resource[natPortForwardRequest, natPortForward, string] {
+	r := resourceNATPortForward()
+	r.AddResource(&schema.Provider{ResourcesMap: map[string]*schema.Resource{}})
+
+	r.list = func(_ context.Context, _ *apiClient, _ string) ([]*natPortForward, error) {
+		list := make([]*natPortForward, len(f.items))
+
+		for i, item := range f.items {
+			listed := *item
+			listed.Id = strconv.Itoa(i)
+			list[i] = &listed
+		}
+
+		return list, nil
+	}
+	r.update = func(_ context.Context, _ *apiClient, id string, request *natPortForwardRequest) (*natPortForward, error) {
+		index, _ := strconv.Atoi(id)
+		f.items[index].Descr = request.Descr
+
+		updated := *f.items[index]
+		updated.Id = id
+
+		return &updated, nil
+	}
+	r.delete = func(_ context.Context, _ *apiClient, _ string, id string) error {
+		index, _ := strconv.Atoi(id)
+		f.items = append(f.items[:index], f.items[index+1:]...)
+
+		return nil
+	}
+
+	return r
+}
+
+// testPortForwardState is the state of the port forward to port, created when it was at position id.
+func testPortForwardState(r *resource[natPortForwardRequest, natPortForward, string], id string, port string) *schema.ResourceData {
+	return r.schemaResource.Data(&terraform.InstanceState{
+		ID: id,
+		Attributes: map[string]string{
+			"id":               id,
+			"interface":        "wan",
+			"protocol":         "tcp",
+			"destination":      "wanip",
+			"destination_port": port,
+			"target":           "10.0.0.2",
+			"description":      "Port " + port,
+		},
+	})
+}
+
+func Test_PositionedItemIsReadAfterItMoves(t *testing.T) {
+	forwards := &testPortForwards{items: []*natPortForward{testPortForward("443", "Port 443")}}
+	r := forwards.resource()
+	d := testPortForwardState(r, "1", "443")
+
+	if diags := r.schemaResource.ReadContext(context.Background(), d, &apiClient{}); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if d.Id() != "0" || d.Get("destination_port") != "443" {
+		t.Errorf("Expected the port forward to be read from position 0 but got %s with port %v", d.Id(), d.Get("destination_port"))
+	}
+}
+
+func Test_PositionedItemIsNotReadFromAnotherItem(t *testing.T) {
+	forwards := &testPortForwards{items: []*natPortForward{testPortForward("80", "Port 80")}}
+	r := forwards.resource()
+	d := testPortForwardState(r, "0", "443")
+
+	diags := r.schemaResource.ReadContext(context.Background(), d, &apiClient{})
+
+	if diags.HasError() || d.Id() != "" {
+		t.Errorf("Expected the port forward to be removed from the state but got %s and %v", d.Id(), diags)
+	}
+}
+
+func Test_PositionedItemIsUpdatedAfterItMoves(t *testing.T) {
+	forwards := &testPortForwards{items: []*natPortForward{testPortForward("443", "Port 443"), testPortForward("22", "Port 22")}}
+	r := forwards.resource()
+	d := testPortForwardState(r, "1", "443")
+	d.Set("description", "HTTPS")
+
+	if diags := r.schemaResource.UpdateContext(context.Background(), d, &apiClient{}); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if forwards.items[0].Descr != "HTTPS" || forwards.items[1].Descr != "Port 22" {
+		t.Errorf("Expected only the port forward to port 443 to be updated but got %s and %s", forwards.items[0].Descr, forwards.items[1].Descr)
+	}
+
+	if d.Id() != "0" {
+		t.Errorf("Expected the ID to be updated to 0 but got %s", d.Id())
+	}
+}
+
+func Test_PositionedItemIsNotUpdatedWhenMissing(t *testing.T) {
+	forwards := &testPortForwards{items: []*natPortForward{testPortForward("80", "Port 80"), testPortForward("22", "Port 22")}}
+	r := forwards.resource()
+	d := testPortForwardState(r, "1", "443")
+	d.Set("description", "HTTPS")
+
+	if diags := r.schemaResource.UpdateContext(context.Background(), d, &apiClient{}); !diags.HasError() {
+		t.Errorf("Expected an error updating a port forward that was removed")
+	}
+
+	if forwards.items[1].Descr != "Port 22" {
+		t.Errorf("Expected the port forward at the position to be left alone but it was updated")
+	}
+}
+
+func Test_PositionedItemIsDeletedAfterItMoves(t *testing.T) {
+	forwards := &testPortForwards{items: []*natPortForward{testPortForward("80", "Port 80"), testPortForward("443", "Port 443")}}
+	r := forwards.resource()
+	d := testPortForwardState(r, "0", "443")
+
+	if diags := r.schemaResource.DeleteContext(context.Background(), d, &apiClient{}); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if len(forwards.items) != 1 || forwards.items[0].Descr != "Port 80" {
+		t.Errorf("Expected only the port forward to port 80 to be left but got %v", forwards.items)
+	}
+}
+
+func Test_DeletingARemovedPositionedItemLeavesTheOthers(t *testing.T) {
+	forwards := &testPortForwards{items: []*natPortForward{testPortForward("80", "Port 80")}}
+	r := forwards.resource()
+	d := testPortForwardState(r, "0", "443")
+
+	if diags := r.schemaResource.DeleteContext(context.Background(), d, &apiClient{}); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if len(forwards.items) != 1 {
+		t.Errorf("Expected the port forward to port 80 to be left but got %v", forwards.items)
+	}
+}
+
+func Test_AmbiguousPositionedItemIsAnError(t *testing.T) {
+	forwards := &testPortForwards{items: []*natPortForward{testPortForward("443", "Port 443"), testPortForward("443", "Port 443")}}
+	r := forwards.resource()
+	d := testPortForwardState(r, "2", "443")
+
+	if diags := r.schemaResource.ReadContext(context.Background(), d, &apiClient{}); !diags.HasError() {
+		t.Errorf("Expected an error reading a port forward matching several items but got %s", d.Id())
+	}
+}
+
+func Test_NewIndexedItem(t *testing.T) {
+	raw := func(items ...string) []json.RawMessage {
+		result := make([]json.RawMessage, len(items))
+
+		for i, item := range items {
+			result[i] = json.RawMessage(item)
+		}
+
+		return result
+	}
+
+	tests := map[string]struct {
+		before   []json.RawMessage
+		after    []json.RawMessage
+		expected int
+	}{
+		"appended":  {before: raw(`{"a":1}`), after: raw(`{"a":1}`, `{"b":1}`), expected: 1},
+		"inserted":  {before: raw(`{"a":1}`, `{"b":1}`), after: raw(`{"c":1}`, `{"a":1}`, `{"b":1}`), expected: 0},
+		"duplicate": {before: raw(`{"a":1}`), after: raw(`{"a":1}`, `{"a":1}`), expected: 1},
+		"removed":   {before: raw(`{"a":1}`, `{"b":1}`), after: raw(`{"b":1}`, `{"c":1}`), expected: 1},
+		"none":      {before: raw(`{"a":1}`), after: raw(`{"a":1}`), expected: -1},
+	}
+
+	for name, test := range tests {
+		if index := newIndexedItem(test.before, test.after); index != test.expected {
+			t.Errorf("%s: expected %d but got %d", name, test.expected, index)
+		}
+	}
+}
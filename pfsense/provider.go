@@ -7,33 +7,72 @@
 // Usage:
 //
 // provider "pfsense" {
-//     url               = "https://192.168.0.1"
+//     url               = "https://192.168.0.1"      // Optional: Or PFSENSE_URL or the credentials file.
 //     user              = "your_username"           // Optional: For local auth.
 //     password          = "your_password"           // Optional: For local auth.
 //     jwt_token         = "your_jwt_token"          // Optional: For JWT auth.
 //     api_client_id     = "your_client_id"          // Optional: For token auth.
 //     api_client_token  = "your_client_token"       // Optional: For token auth.
-//     skip_tls          = false                     // Optional: Default is false.
+//     endpoint {                                    // Optional: Instead of url, e.g. for HA pairs.
+//         name = "primary"
+//         url  = "https://192.168.0.2"
+//     }
+//     endpoint {
+//         name = "secondary"
+//         url  = "https://192.168.0.3"
+//     }
+//     default_target_endpoint = "primary"           // Optional: Default is the first endpoint.
+//     credentials_file  = "~/.pfsense/credentials"  // Optional: Or PFSENSE_CREDENTIALS_FILE.
+//     profile           = "default"                 // Optional: Or PFSENSE_PROFILE.
+//     tls_mode          = "verify"                  // Optional: verify, skip or pin. Default is verify.
+//     tls_fingerprints  = ["AB:CD:..."]             // Optional: Accepted certificates when tls_mode is pin.
+//     ca_cert_file      = "/path/to/ca.pem"         // Optional: Or ca_cert_pem, default is the system CAs.
+//     client_cert       = file("client.pem")        // Optional: For mutual TLS.
+//     client_key        = file("client-key.pem")    // Optional: For mutual TLS.
+//     tls_server_name   = "pfsense.example.com"     // Optional: Default is the host of url.
 //     timeout           = 30                        // Optional: Default is 30 seconds.
+//     apply_mode        = "immediate"               // Optional: Default is immediate.
+//     max_concurrent_writes = 1                     // Optional: Default is 1.
+//     read_cache_ttl    = 300                       // Optional: Default is 300 seconds.
+//     config_snapshot   = true                      // Optional: Default is false.
+//     rollback_on_error = false                     // Optional: Default is false.
+//     commit_confirm {                              // Optional: Default is disabled.
+//         window = 120
+//     }
+//     retry {                                       // Optional: Default is 3 attempts.
+//         max_attempts    = 3
+//         min_backoff     = 1
+//         max_backoff     = 30
+//         retry_on_status = [502, 503, 504]
+//     }
 // }
 //
 // Notes:
 // - JWTAuthEnabled is inferred from the presence of `jwt_token`.
 // - LocalAuthEnabled is inferred from the presence of `user`.
 // - TokenAuthEnabled is inferred from the presence of `api_client_id`.
+// - Authentication settings can also be set with PFSENSE_USER, PFSENSE_PASSWORD, PFSENSE_JWT_TOKEN,
+//   PFSENSE_API_CLIENT_ID and PFSENSE_API_CLIENT_TOKEN, or in a profile of the credentials file, e.g.
+//
+//   [home]
+//   url              = https://192.168.1.1
+//   api_client_id    = your_client_id
+//   api_client_token = your_client_token
 //
 // Created by: [Your Name or Alias]
 // Date: [Creation Date]
 // Target Terraform Version: [X.X.X]
-// Target pfSense API Version: [X.X.X]
+// Target pfSense API Version: v1, the version installed on every endpoint is checked when the provider is configured
+// if it can be queried, and is available from the pfsense_system_version data source.
 
 package pfsense
 
 import (
+	"context"
 	"errors"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
@@ -45,42 +84,134 @@ func Provider() *schema.Provider {
 		Schema: map[string]*schema.Schema{
 			"url": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("PFSENSE_URL", nil),
 				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
-				Description:  "The url of the target pfsense e.g https://192.168.1.1",
+				Description:  "The url of the target pfsense e.g https://192.168.1.1, can also be set with `PFSENSE_URL` or in the credentials file. Required unless `endpoint` is set.",
+			},
+			"endpoint": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"url"},
+				Description:   "pfSense hosts managed by the provider instead of `url`, e.g. both nodes of a CARP HA pair. Every endpoint uses the same credentials and settings, resources choose which endpoints they are applied to with `target_endpoint`. The first endpoint is used by data sources and imports.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Name resources target the endpoint by, e.g. `primary` or `secondary`.",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Description:  "The url of the endpoint e.g https://192.168.1.2",
+						},
+					},
+				},
+			},
+			"default_target_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Endpoints resources are applied to when they don't set `target_endpoint`, the name of an endpoint or `all`. If not specified, resources are only applied to the first endpoint, e.g. when the configuration is synchronised to the secondary node with XMLRPC sync.",
 			},
 			"user": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Local authentication username.",
+				DefaultFunc: schema.EnvDefaultFunc("PFSENSE_USER", nil),
+				Description: "Local authentication username. Can also be set with `PFSENSE_USER` or in the credentials file.",
 			},
 			"password": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Local authentication password.",
+				DefaultFunc: schema.EnvDefaultFunc("PFSENSE_PASSWORD", nil),
+				Description: "Local authentication password. Can also be set with `PFSENSE_PASSWORD` or in the credentials file.",
 				Sensitive:   true,
 			},
 			"jwt_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "JWT token for authentication.",
+				DefaultFunc: schema.EnvDefaultFunc("PFSENSE_JWT_TOKEN", nil),
+				Description: "JWT token for authentication. Can also be set with `PFSENSE_JWT_TOKEN` or in the credentials file.",
 				Sensitive:   true,
 			},
 			"api_client_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "API Client ID for token-based authentication.",
+				DefaultFunc: schema.EnvDefaultFunc("PFSENSE_API_CLIENT_ID", nil),
+				Description: "API Client ID for token-based authentication. Can also be set with `PFSENSE_API_CLIENT_ID` or in the credentials file.",
 			},
 			"api_client_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "API Client Token for token-based authentication.",
+				DefaultFunc: schema.EnvDefaultFunc("PFSENSE_API_CLIENT_TOKEN", nil),
+				Description: "API Client Token for token-based authentication. Can also be set with `PFSENSE_API_CLIENT_TOKEN` or in the credentials file.",
 				Sensitive:   true,
 			},
+			"credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("PFSENSE_CREDENTIALS_FILE", nil),
+				Description: "Path of a file with a section of settings per firewall, see `profile`. Can also be set with `PFSENSE_CREDENTIALS_FILE`, defaults to `~/.pfsense/credentials` if it exists. The file can set `url`, `user`, `password`, `jwt_token`, `api_client_id` and `api_client_token`, settings in the provider block or environment variables take precedence.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("PFSENSE_PROFILE", nil),
+				Description: "Section of the credentials file to use, e.g. `[home]`. Can also be set with `PFSENSE_PROFILE`, defaults to `default`.",
+			},
 			"allow_insecure": {
-				Type:        schema.TypeBool,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"tls_mode"},
+				Deprecated:    "Use tls_mode = \"skip\" instead.",
+				Description:   "Skip TLS verification, the same as `tls_mode = \"skip\"`.",
+			},
+			"tls_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "How the certificate of pfSense is checked. `verify` checks it was issued for the host by a trusted CA, `skip` accepts any certificate and `pin` only accepts certificates with one of the `tls_fingerprints`, whoever issued them. If not specified, it defaults to `verify`.",
+				ValidateFunc: validation.StringInSlice(tlsModes, false),
+			},
+			"tls_fingerprints": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Skip TLS verification. If not specified, it defaults to true unless the url uses HTTPS.",
+				Description: "SHA-256 fingerprints of the certificates accepted when `tls_mode` is `pin`, in hex with or without colons, e.g. the output of `openssl x509 -noout -fingerprint -sha256`. List the current and next certificate when rotating it.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexValidator(`^(?:[0-9a-fA-F]{2}:?){31}[0-9a-fA-F]{2}$`), "Invalid SHA-256 fingerprint"),
+				},
+			},
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ca_cert_file"},
+				Description:   "PEM encoded CA certificates to verify the certificate of pfSense with instead of the system's, e.g. of an internal PKI.",
+			},
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ca_cert_pem"},
+				Description:   "Path of a file with PEM encoded CA certificates to verify the certificate of pfSense with instead of the system's.",
+			},
+			"client_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_key"},
+				Description:  "PEM encoded client certificate for mutual TLS, e.g. with a reverse proxy in front of pfSense. Use `file()` to read it from a file.",
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"client_cert"},
+				Description:  "PEM encoded private key of `client_cert`. Use `file()` to read it from a file.",
+			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name the certificate of pfSense is verified against and sent with SNI, if not specified the host of `url` is used. Useful when connecting by IP address to a certificate issued for a name.",
 			},
 			"timeout": {
 				Type:        schema.TypeInt,
@@ -88,9 +219,100 @@ func Provider() *schema.Provider {
 				Description: "Request timeout duration in seconds.",
 				Default:     60,
 			},
+			"read_cache_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				Description:  "Seconds the list of a resource type is kept for when refreshing, so that resources of the same type share one download of the list instead of each downloading it. Lists are downloaded again after any resource of the type is changed, `0` disables the cache.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_concurrent_writes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				Description:  "Maximum number of changes sent to the pfSense host at the same time, reads aren't limited. pfSense keeps its configuration in a single file and concurrent changes can overwrite each other, so only raise this if the API on the host serializes changes itself. The limit only applies to the changes made by this provider configuration, Terraform runs every provider configuration in its own process.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Retry requests that fail while pfSense is busy, e.g. reloading the filter. Reads are retried on network errors and on `retry_on_status`, changes are only retried when pfSense couldn't be reached so that nothing is ever created twice. If not specified, requests are attempted up to 3 times. `timeout` applies to all attempts of a request together.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3,
+							Description:  "Maximum number of times a request is sent, `1` disables retries.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min_backoff": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							Description:  "Seconds to wait before the first retry, the wait doubles after every attempt.",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"max_backoff": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							Description:  "Maximum seconds to wait between attempts.",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"retry_on_status": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "HTTP status codes reads are retried on, defaults to 502, 503 and 504.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(100, 599),
+							},
+						},
+					},
+				},
+			},
+			"apply_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      applyModeImmediate,
+				Description:  "When changes are applied. `immediate` reloads the affected subsystem after every change, `deferred` only saves the changes and leaves applying them to a `pfsense_apply` resource, which applies each changed subsystem once.",
+				ValidateFunc: validation.StringInSlice([]string{applyModeImmediate, applyModeDeferred}, false),
+			},
+			"config_snapshot": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Back up the configuration before the first change of each run, the backup is kept in the configuration history of pfSense. The backup is taken with the `diagnostics/command_prompt` endpoint of the API, so the user of the provider needs access to it.",
+			},
+			"rollback_on_error": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Restore the backup taken by `config_snapshot` if any change fails, changes after the failure are refused. Requires `config_snapshot`.",
+			},
+			"commit_confirm": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Guard against changes that cut the provider off from pfSense, such as changing the address of an interface or the firewall rules of the management interface. Before changing a `pfsense_interface`, `pfsense_firewall_rule` or `pfsense_firewall_rule_order`, or applying with `pfsense_apply`, pfSense is told to restore the backup taken by `config_snapshot` once `window` has passed. The restore is cancelled if the provider can still reach the API after the change. Requires `config_snapshot`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"window": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      120,
+							Description:  "Seconds after a change before pfSense restores the backup, the provider has three quarters of the window to reach the API again.",
+							ValidateFunc: validation.IntAtLeast(30),
+						},
+					},
+				},
+			},
 		},
-		ResourcesMap:  map[string]*schema.Resource{},
-		ConfigureFunc: providerConfigure,
+		ResourcesMap:         map[string]*schema.Resource{},
+		DataSourcesMap:       map[string]*schema.Resource{},
+		ConfigureContextFunc: providerConfigure,
 	}
 
 	resourceFirewallAlias().AddResource(provider)
@@ -100,49 +322,127 @@ func Provider() *schema.Provider {
 	resourceInterface().AddResource(provider)
 	resourceInterfaceVLAN().AddResource(provider)
 	resourceUnboundHostOverride().AddResource(provider)
+	resourceNATPortForward().AddResource(provider)
+	resourceNATOutboundMapping().AddResource(provider)
+	resourceNATOutboundMode().AddResource(provider)
+	resourceNATOneToOne().AddResource(provider)
+	resourceRoutingGateway().AddResource(provider)
+	resourceRoutingGatewayGroup().AddResource(provider)
+	resourceRoutingStaticRoute().AddResource(provider)
+	resourceFirewallSchedule().AddResource(provider)
+	resourceTrafficShaperLimiter().AddResource(provider)
+	resourceTrafficShaperQueue().AddResource(provider)
+	resourceFirewallRuleOrder().AddResource(provider)
+	resourceApply().AddResource(provider)
+	resourceConfigBackup().AddResource(provider)
+
+	resourceFirewallAlias().AddDataSource(provider)
+	resourceDHCPServer().AddDataSource(provider)
+	resourceFirewallRule().AddDataSource(provider)
+	resourceDHCPStaticMapping().AddDataSource(provider)
+	resourceInterface().AddDataSource(provider)
+	resourceInterfaceVLAN().AddDataSource(provider)
+	resourceUnboundHostOverride().AddDataSource(provider)
+
+	resourceFirewallRule().AddListDataSource(provider, "pfsense_firewall_rules", "Firewall Rules")
+	resourceDHCPStaticMapping().AddListDataSource(provider, "pfsense_dhcp_static_mappings", "IPv4 DHCP Static Mappings of an interface")
+	resourceInterface().AddListDataSource(provider, "pfsense_interfaces", "Interfaces")
+
+	provider.DataSourcesMap["pfsense_system_version"] = dataSourceSystemVersion()
 
 	return provider
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	url := d.Get("url").(string)
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	client, err := newProviderClient(d)
+
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
 
-	d.Get("allow_insecure")
-	allowInsecure := d.Get("allow_insecure").(bool) || strings.HasPrefix(url, "https://")
+	diags := client.checkAPIVersions(ctx)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return client, diags
+}
+
+// newProviderClient creates the clients of the endpoints from the provider configuration without connecting to them.
+func newProviderClient(d *schema.ResourceData) (*apiClient, error) {
+	profile, err := loadCredentialsProfile(d.Get("credentials_file").(string), d.Get("profile").(string))
+
+	if err != nil {
+		return nil, err
+	}
+
+	// settings in the provider block or environment take precedence over the credentials file
+	setting := func(name string) (string, bool) {
+		if value, ok := d.GetOk(name); ok {
+			return value.(string), true
+		}
+
+		value, ok := profile[name]
+
+		return value, ok && value != ""
+	}
+
+	url, ok := setting("url")
+
+	if _, hasEndpoints := d.GetOk("endpoint"); !ok && !hasEndpoints {
+		return nil, errors.New("url is required, set it in the provider block, with PFSENSE_URL or in the credentials file")
+	}
+
+	tlsMode := d.Get("tls_mode").(string)
+
+	if tlsMode == "" {
+		tlsMode = tlsModeVerify
+
+		if d.Get("allow_insecure").(bool) {
+			tlsMode = tlsModeSkip
+		}
+	}
+
+	fingerprints, err := interfaceToStringArray(d.Get("tls_fingerprints"))
+
+	if err != nil {
+		return nil, err
+	}
 
 	c := pfsenseapi.Config{
 		Host:    url,
-		SkipTLS: allowInsecure,
+		SkipTLS: tlsMode != tlsModeVerify,
 		Timeout: time.Duration(d.Get("timeout").(int)) * time.Second,
 	}
 
 	// Check for JWT auth
-	if jwtToken, ok := d.GetOk("jwt_token"); ok {
+	if jwtToken, ok := setting("jwt_token"); ok {
 		c.JWTAuthEnabled = true
-		c.JWTToken = jwtToken.(string)
+		c.JWTToken = jwtToken
 	}
 
 	// Check for local auth
-	if user, ok := d.GetOk("user"); ok {
+	if user, ok := setting("user"); ok {
 		c.LocalAuthEnabled = true
-		c.User = user.(string)
+		c.User = user
 
-		if password, ok := d.GetOk("password"); !ok {
+		if password, ok := setting("password"); !ok {
 			return nil, errors.New("password is required when username is provided")
 		} else {
-			c.Password = password.(string)
+			c.Password = password
 		}
 	}
 
 	// Check for token auth
-	if clientID, ok := d.GetOk("api_client_id"); ok {
+	if clientID, ok := setting("api_client_id"); ok {
 		c.TokenAuthEnabled = true
-		c.ApiClientID = clientID.(string)
+		c.ApiClientID = clientID
 
-		if clientToken, ok := d.GetOk("api_client_token"); !ok {
+		if clientToken, ok := setting("api_client_token"); !ok {
 			return nil, errors.New("api_client_token is required when api_client_id is provided")
 		} else {
-			c.ApiClientToken = clientToken.(string)
+			c.ApiClientToken = clientToken
 		}
 	}
 
@@ -162,6 +462,67 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, errors.New("only one form of authentication should be provided")
 	}
 
-	client := pfsenseapi.NewClient(c)
-	return client, nil
+	settings := apiClientSettings{
+		maxConcurrentWrites: d.Get("max_concurrent_writes").(int),
+		applyMode:           d.Get("apply_mode").(string),
+		retry:               defaultRetryPolicy(),
+		readCacheTTL:        time.Duration(d.Get("read_cache_ttl").(int)) * time.Second,
+		snapshot:            d.Get("config_snapshot").(bool),
+		rollbackOnError:     d.Get("rollback_on_error").(bool),
+		tls: tlsSettings{
+			mode:         tlsMode,
+			fingerprints: fingerprints,
+			caCertPEM:    d.Get("ca_cert_pem").(string),
+			caCertFile:   d.Get("ca_cert_file").(string),
+			clientCert:   d.Get("client_cert").(string),
+			clientKey:    d.Get("client_key").(string),
+			serverName:   d.Get("tls_server_name").(string),
+		},
+	}
+
+	if settings.rollbackOnError && !settings.snapshot {
+		return nil, errors.New("config_snapshot is required when rollback_on_error is enabled")
+	}
+
+	if confirm, ok := d.GetOk("commit_confirm"); ok && len(confirm.([]interface{})) > 0 {
+		settings.confirmWindow = 120 * time.Second
+
+		if confirm.([]interface{})[0] != nil {
+			settings.confirmWindow = time.Duration(confirm.([]interface{})[0].(map[string]interface{})["window"].(int)) * time.Second
+		}
+
+		if !settings.snapshot {
+			return nil, errors.New("config_snapshot is required when commit_confirm is configured")
+		}
+	}
+
+	if retry, ok := d.GetOk("retry"); ok && len(retry.([]interface{})) > 0 && retry.([]interface{})[0] != nil {
+		retryMap := retry.([]interface{})[0].(map[string]interface{})
+		settings.retry.maxAttempts = retryMap["max_attempts"].(int)
+		settings.retry.minBackoff = time.Duration(retryMap["min_backoff"].(int)) * time.Second
+		settings.retry.maxBackoff = time.Duration(retryMap["max_backoff"].(int)) * time.Second
+
+		retryOnStatus, err := interfaceToIntArray(retryMap["retry_on_status"])
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(retryOnStatus) > 0 {
+			settings.retry.retryOnStatus = retryOnStatus
+		}
+	}
+
+	endpoints := []apiEndpoint{{name: defaultEndpointName, url: url}}
+
+	if configured, ok := d.GetOk("endpoint"); ok {
+		endpoints = []apiEndpoint{}
+
+		for _, i := range configured.([]interface{}) {
+			endpoint := i.(map[string]interface{})
+			endpoints = append(endpoints, apiEndpoint{name: endpoint["name"].(string), url: endpoint["url"].(string)})
+		}
+	}
+
+	return newAPIClients(c, endpoints, d.Get("default_target_endpoint").(string), settings)
 }
@@ -2,26 +2,27 @@ package pfsense
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
-	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
 )
 
+// idSeparator separates the parts of generated IDs, partitioned resources created by earlier versions of the provider
+// used it to separate the partition from the ID.
 const idSeparator = "."
 
 type updateRequestFunc[RequestType any] func(*schema.ResourceData, string, *RequestType) error
 type getFromResourceFunc[ResponseType any] func(*ResponseType) (interface{}, error)
 
-type updateFunc[RequestType any, ResponseType any, IdType ~string | ~int] func(context.Context, *pfsenseapi.Client, IdType, *RequestType) (*ResponseType, error)
-type createFunc[RequestType any, ResponseType any] func(context.Context, *pfsenseapi.Client, *RequestType) (*ResponseType, error)
-type listFunc[ResponseType any] func(context.Context, *pfsenseapi.Client, string) ([]*ResponseType, error)
-type deleteFunc[IdType ~string | ~int] func(context.Context, *pfsenseapi.Client, string, IdType) error
+type updateFunc[RequestType any, ResponseType any, IdType ~string | ~int] func(context.Context, *apiClient, IdType, *RequestType) (*ResponseType, error)
+type createFunc[RequestType any, ResponseType any] func(context.Context, *apiClient, *RequestType) (*ResponseType, error)
+type listFunc[ResponseType any] func(context.Context, *apiClient, string) ([]*ResponseType, error)
+type getFunc[ResponseType any, IdType ~string | ~int] func(context.Context, *apiClient, string, IdType) (*ResponseType, error)
+type deleteFunc[IdType ~string | ~int] func(context.Context, *apiClient, string, IdType) error
 type disableFunc[RequestType any] func(*RequestType) error
 
 var dnsValidator schema.SchemaValidateFunc = validation.StringMatch(regexValidator(`^(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,6}$`), "Invalid DNS Name")
@@ -34,12 +35,14 @@ type resourceProperty[RequestType any, ResponseType any] struct {
 	updateRequest   updateRequestFunc[RequestType]
 	getFromResponse getFromResourceFunc[ResponseType]
 	validValues     []string
+	// minimumVersion is the version of the pfSense API that first supported the property
+	minimumVersion string
 }
 
 type resource[RequestType any, ResponseType any, IdType ~string | ~int] struct {
 	name        string
 	description string
-	getId       func(context.Context, *pfsenseapi.Client, *ResponseType) (IdType, error)
+	getId       func(context.Context, *apiClient, *ResponseType) (IdType, error)
 	partitionId string
 	update      updateFunc[RequestType, ResponseType, IdType]
 	create      createFunc[RequestType, ResponseType]
@@ -47,10 +50,55 @@ type resource[RequestType any, ResponseType any, IdType ~string | ~int] struct {
 	disable     disableFunc[RequestType]
 	list        listFunc[ResponseType]
 	properties  map[string]*resourceProperty[RequestType, ResponseType]
+	// get fetches a single item, resources without it are read from their list
+	get getFunc[ResponseType, IdType]
+	// minimumVersion is the version of the pfSense API that first supported the resource
+	minimumVersion string
+	// schemaResource is the Terraform resource, set when the resource is added to the provider
+	schemaResource *schema.Resource
+	// confirm is set on resources whose changes can cut the provider off from pfSense, their changes are
+	// confirmed when commit_confirm is configured.
+	confirm bool
+	// importKeys are properties that identify an item when importing it, as an alternative to its ID
+	importKeys []string
+	// indexed is set on resources that pfSense identifies by their position in a list, their writes hold the
+	// client's indexLock so that positions don't change between finding an item and changing it.
+	indexed bool
+	// positionKeys are string properties of indexed resources whose ID is their position. The position changes when
+	// items before it are removed, so items are only read, updated and deleted at their position when these properties
+	// still match the state, otherwise they're looked up by them.
+	positionKeys []string
+	// customizeDiff plans changes that aren't made to the properties, it runs after the checks of every resource
+	customizeDiff schema.CustomizeDiffFunc
+}
+
+func (r *resource[RequestType, ResponseType, IdType]) write(ctx context.Context, client *apiClient, change func() error) error {
+	defer client.invalidateLists(r.name)
+
+	if r.indexed {
+		indexedChange := change
+
+		change = func() error {
+			client.indexLock.Lock()
+			defer client.indexLock.Unlock()
+
+			return indexedChange()
+		}
+	}
+
+	if r.confirm {
+		return client.writeConfirmed(ctx, change)
+	}
+
+	return client.write(ctx, change)
 }
 
 func (r *resource[RequestType, ResponseType, IdType]) updateRequest(d *schema.ResourceData, request *RequestType) error {
 	for name, prop := range r.properties {
+		if prop.updateRequest == nil {
+			continue
+		}
+
 		value, exists := d.GetOk(name)
 
 		if exists {
@@ -103,168 +151,425 @@ func (r *resource[RequestType, ResponseType, IdType]) updateResource(d *schema.R
 
 func (r *resource[RequestType, ResponseType, IdType]) GetCreateFunction() schema.CreateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-		client := m.(*pfsenseapi.Client)
-		request := new(RequestType)
-
-		if err := r.updateRequest(d, request); err != nil {
-			return diag.FromErr(err)
-		}
-
-		response, err := r.create(ctx, client, request)
+		targets, err := r.targets(d, m)
 
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		if err := r.updateResource(d, response); err != nil {
+		request := new(RequestType)
+
+		if err := r.updateRequest(d, request); err != nil {
 			return diag.FromErr(err)
 		}
 
-		id, err := r.getId(ctx, client, response)
+		partition, err := r.partition(d)
 
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		if reflect.ValueOf(id).IsZero() {
-			return diag.Errorf("Invalid ID returned for %s: '%s'", r.name, fmt.Sprint(id))
-		}
+		endpointIds := map[string]interface{}{}
 
-		if r.partitionId != "" {
-			i, ok := d.GetOk(r.partitionId)
+		for i, client := range targets {
+			response, id, err := r.createOnEndpoint(ctx, client, request, partition)
 
-			if !ok {
-				return diag.Errorf("Field %s is required, provider error, should be already validated", r.partitionId)
+			if err == nil && i == 0 {
+				err = r.updateResource(d, response)
 			}
 
-			parition, ok := i.(string)
+			if err != nil {
+				// keep what was created on the other endpoints so that it's replaced by the next apply
+				if i > 0 {
+					d.SetId(endpointIds[targets[0].name].(string))
+					d.Set(targetIdsProperty, endpointIds)
+				}
 
-			if !ok {
-				return diag.Errorf("Field %s should be a string, provider error, should be already validated", r.partitionId)
+				return diag.FromErr(err)
 			}
 
-			d.SetId(fmt.Sprintf("%s%s%s", parition, idSeparator, fmt.Sprint(id)))
-		} else {
-			d.SetId(fmt.Sprint(id))
+			endpointIds[client.name] = id
+		}
+
+		d.SetId(endpointIds[targets[0].name].(string))
+
+		if err := d.Set(targetIdsProperty, endpointIds); err != nil {
+			return diag.FromErr(err)
 		}
 
 		return nil
 	}
 }
 
-func (r *resource[RequestType, ResponseType, IdType]) UpdateFromId(ctx context.Context, client *pfsenseapi.Client, d *schema.ResourceData) error {
-	var list []*ResponseType
-	var err error
+// partition returns the value of the partition property, which is validated by the schema.
+func (r *resource[RequestType, ResponseType, IdType]) partition(d *schema.ResourceData) (string, error) {
+	if r.partitionId == "" {
+		return "", nil
+	}
 
-	partition, id, err := r.getResourceId(d)
+	i, ok := d.GetOk(r.partitionId)
 
-	if err != nil {
+	if !ok {
+		return "", fmt.Errorf("Field %s is required, provider error, should be already validated", r.partitionId)
+	}
+
+	partition, ok := i.(string)
+
+	if !ok {
+		return "", fmt.Errorf("Field %s should be a string, provider error, should be already validated", r.partitionId)
+	}
+
+	return partition, nil
+}
+
+// createOnEndpoint creates the item on an endpoint and returns it with its ID.
+func (r *resource[RequestType, ResponseType, IdType]) createOnEndpoint(ctx context.Context, client *apiClient, request *RequestType, partition string) (*ResponseType, string, error) {
+	var response *ResponseType
+
+	err := r.write(ctx, client, func() (err error) {
+		response, err = r.create(ctx, client, request)
 		return err
+	})
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	id, err := r.getId(ctx, client, response)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	if reflect.ValueOf(id).IsZero() {
+		return nil, "", fmt.Errorf("Invalid ID returned for %s: '%s'", r.name, fmt.Sprint(id))
+	}
+
+	return response, r.formatId(partition, id), nil
+}
+
+func (r *resource[RequestType, ResponseType, IdType]) UpdateFromId(ctx context.Context, client *apiClient, d *schema.ResourceData) error {
+	return r.read(ctx, client, d, len(r.positionKeys) > 0)
+}
+
+// read updates the state from the item with the ID of the state. When locate is set the item is looked up by its
+// positionKeys if it has moved, imported items aren't in the state yet and are read at their position.
+func (r *resource[RequestType, ResponseType, IdType]) read(ctx context.Context, client *apiClient, d *schema.ResourceData, locate bool) error {
+	resourceId, ok := endpointId(d, client)
+
+	if !ok {
+		return fmt.Errorf("%w, it hasn't been created yet", errNotFound)
 	}
 
-	list, err = r.list(ctx, client, partition)
+	partition, id, err := r.parseResourceId(resourceId)
 
 	if err != nil {
 		return err
 	}
 
-	for _, item := range list {
-		itemId, err := r.getId(ctx, client, item)
+	var item *ResponseType
+
+	if locate {
+		list, err := r.cachedList(ctx, client, partition)
 
 		if err != nil {
-			return fmt.Errorf("Unable to get Id from listed value, received err: %v", err)
+			return err
 		}
 
-		if id == itemId {
-			if err = r.updateResource(d, item); err != nil {
-				return err
-			}
+		var newId IdType
 
-			if r.partitionId != "" {
-				if err := d.Set(r.partitionId, partition); err != nil {
-					return err
-				}
-			}
+		if item, newId, err = r.locate(ctx, client, d, id, list); err != nil {
+			return err
+		}
 
-			return nil
+		if item != nil {
+			id = newId
+		}
+	} else if item, err = r.find(ctx, client, partition, id); err != nil {
+		return err
+	}
+
+	if item == nil {
+		var partitionErrorText string
+
+		if r.partitionId != "" {
+			partitionErrorText = fmt.Sprintf(" and with %s equal to %s", r.partitionId, partition)
 		}
+
+		return fmt.Errorf("%w with Id %s%s", errNotFound, fmt.Sprint(id), partitionErrorText)
 	}
 
-	var partitionErrorText string
+	// the item moved or its ID is in the format used before IDs were escaped, e.g. lan.10.0.0.1
+	if formatted := r.formatId(partition, id); formatted != resourceId {
+		setEndpointId(d, client, formatted)
+	}
+
+	if err = r.updateResource(d, item); err != nil {
+		return err
+	}
 
 	if r.partitionId != "" {
-		partitionErrorText = fmt.Sprintf(" and with %s equal to %s", r.partitionId, partition)
+		if err := d.Set(r.partitionId, partition); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// find gets an item directly when the resource supports it, otherwise it's looked up in the list of items which is
+// shared by every resource of the same type.
+func (r *resource[RequestType, ResponseType, IdType]) find(ctx context.Context, client *apiClient, partition string, id IdType) (*ResponseType, error) {
+	if r.get != nil {
+		return r.get(ctx, client, partition, id)
+	}
+
+	list, err := r.cachedList(ctx, client, partition)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range list {
+		itemId, err := r.getId(ctx, client, item)
+
+		if err != nil {
+			return nil, fmt.Errorf("Unable to get Id from listed value, received err: %v", err)
+		}
+
+		if id == itemId {
+			return item, nil
+		}
 	}
 
-	return fmt.Errorf("Unable to find item with Id %s%s", fmt.Sprint(id), partitionErrorText)
+	return nil, nil
+}
+
+// cachedList lists the items of a partition, the list is shared by every resource of the same type.
+func (r *resource[RequestType, ResponseType, IdType]) cachedList(ctx context.Context, client *apiClient, partition string) ([]*ResponseType, error) {
+	list, err := client.cachedList(ctx, listCacheKey(r.name, partition), func() (interface{}, error) {
+		return r.list(ctx, client, partition)
+	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return list.([]*ResponseType), nil
 }
 
 func (r *resource[RequestType, ResponseType, IdType]) GetReadFunction() schema.ReadContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-		client := m.(*pfsenseapi.Client)
-		if err := r.UpdateFromId(ctx, client, d); err != nil {
+		targets, err := r.targets(d, m)
+
+		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		return nil
+		// Read every endpoint into a copy of the state and keep the first that changed, so that a change made on any
+		// endpoint is planned and applied to all of them
+		drifted := targets[0]
+		var diags diag.Diagnostics
+
+		if len(targets) > 1 {
+			prior := d.State()
+			var found []*apiClient
+			var missing []error
+			var hasDrift bool
+
+			for _, client := range targets {
+				endpointData := r.schemaResource.Data(prior)
+
+				if err := r.UpdateFromId(ctx, client, endpointData); err != nil {
+					if errors.Is(err, errNotFound) {
+						missing = append(missing, fmt.Errorf("%w on endpoint %s", err, client.name))
+						continue
+					}
+
+					return diag.Errorf("Unable to read %s from endpoint %s: %v", r.name, client.name, err)
+				}
+
+				changed := !reflect.DeepEqual(endpointData.State().Attributes, prior.Attributes)
+
+				if len(found) == 0 || (changed && !hasDrift) {
+					drifted = client
+					hasDrift = changed
+				}
+
+				found = append(found, client)
+			}
+
+			if len(found) == 0 {
+				return r.removeMissing(d, targets[0], errors.Join(missing...))
+			}
+
+			if len(missing) > 0 {
+				diags = r.removeMissingEndpoints(d, found, errors.Join(missing...))
+			}
+		}
+
+		if err := r.UpdateFromId(ctx, drifted, d); err != nil {
+			if errors.Is(err, errNotFound) {
+				return r.removeMissing(d, drifted, err)
+			}
+
+			return diag.FromErr(err)
+		}
+
+		return diags
 	}
 }
 
-func (r *resource[RequestType, ResponseType, IdType]) getResourceId(d *schema.ResourceData) (string, IdType, error) {
-	var partition string
+// removeMissingEndpoints keeps only the IDs of the endpoints the resource was found on, so that the next apply
+// creates it again on the endpoints it was deleted from outside of Terraform.
+func (r *resource[RequestType, ResponseType, IdType]) removeMissingEndpoints(d *schema.ResourceData, found []*apiClient, err error) diag.Diagnostics {
+	endpointIds := map[string]interface{}{}
+
+	for _, client := range found {
+		endpointIds[client.name], _ = endpointId(d, client)
+	}
+
+	if err := d.Set(targetIdsProperty, endpointIds); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s %s no longer exists on every endpoint", r.name, d.Id()),
+		Detail:   fmt.Sprintf("%v. It was probably deleted outside of Terraform, it will be created again on those endpoints.", err),
+	}}
+}
+
+// removeMissing removes a resource that was deleted outside of Terraform from the state so that it's created again.
+func (r *resource[RequestType, ResponseType, IdType]) removeMissing(d *schema.ResourceData, client *apiClient, err error) diag.Diagnostics {
 	id := d.Id()
+	d.SetId("")
+
+	var endpoint string
+
+	if client.name != "" {
+		endpoint = fmt.Sprintf(" on endpoint %s", client.name)
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s %s no longer exists", r.name, id),
+		Detail:   fmt.Sprintf("%v%s. It was probably deleted outside of Terraform and has been removed from the state, it will be created again.", err, endpoint),
+	}}
+}
+
+// targets returns the clients of the endpoints the resource is applied to.
+func (r *resource[RequestType, ResponseType, IdType]) targets(d *schema.ResourceData, m interface{}) ([]*apiClient, error) {
+	target, _ := d.Get(targetProperty).(string)
+
+	return m.(*apiClient).targets(target)
+}
+
+// endpointId returns the ID of the resource on an endpoint in the state, or false when it isn't on the endpoint.
+// Resources created before endpoints were supported or imported only have the ID of the resource.
+func endpointId(d *schema.ResourceData, client *apiClient) (string, bool) {
+	// the IDs are unknown while applying a plan that creates the resource on an endpoint it's missing from
+	prior, _ := d.GetChange(targetIdsProperty)
+	ids, _ := prior.(map[string]interface{})
 
+	if len(ids) == 0 {
+		return d.Id(), true
+	}
+
+	id, _ := ids[client.name].(string)
+
+	return id, id != ""
+}
+
+func (r *resource[RequestType, ResponseType, IdType]) formatId(partition string, id IdType) string {
 	if r.partitionId != "" {
-		parts := splitIntoArray(id, idSeparator)
-		partition = parts[0]
-		id = strings.Join(parts[1:], idSeparator)
+		return fmt.Sprintf("%s%s%s", escapeIdPart(partition), partitionSeparator, escapeIdPart(fmt.Sprint(id)))
 	}
 
-	var example interface{} = new(IdType)
-	var zeroValue IdType
+	return fmt.Sprint(id)
+}
 
-	switch example.(type) {
-	case *int:
-		number, err := strconv.Atoi(id)
+func (r *resource[RequestType, ResponseType, IdType]) getResourceId(d *schema.ResourceData) (string, IdType, error) {
+	return r.parseResourceId(d.Id())
+}
 
-		if err != nil {
-			return "", zeroValue, nil
-		}
+func (r *resource[RequestType, ResponseType, IdType]) parseResourceId(id string) (string, IdType, error) {
+	var partition string
+
+	if r.partitionId != "" {
+		partition, id = splitPartition(id)
+	}
 
-		value := any(number).(IdType)
+	value, err := parseIdValue[IdType](id)
 
-		return partition, value, nil
-	case *string:
-		value := any(id).(IdType)
-		return partition, value, nil
+	if errors.Is(err, errInvalidId) {
+		var zeroValue IdType
+		return "", zeroValue, nil
 	}
 
-	return "", zeroValue, fmt.Errorf("Unable to determine type of example %v", example)
+	return partition, value, err
 }
 
 func (r *resource[RequestType, ResponseType, IdType]) GetUpdateFunction() schema.UpdateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-		client := m.(*pfsenseapi.Client)
+		targets, err := r.targets(d, m)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
 		request := new(RequestType)
 
 		if err := r.updateRequest(d, request); err != nil {
 			return diag.FromErr(err)
 		}
 
-		_, id, err := r.getResourceId(d)
+		partition, err := r.partition(d)
 
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		response, err := r.update(ctx, client, id, request)
+		var updated *ResponseType
+		endpointIds := map[string]interface{}{}
 
-		if err != nil {
-			return diag.FromErr(err)
+		for _, client := range targets {
+			resourceId, ok := endpointId(d, client)
+
+			// create it again on the endpoints it was deleted from outside of Terraform
+			if !ok {
+				response, id, err := r.createOnEndpoint(ctx, client, request, partition)
+
+				if err != nil {
+					r.setEndpointIds(d, targets, endpointIds)
+					return diag.FromErr(err)
+				}
+
+				endpointIds[client.name] = id
+
+				if updated == nil {
+					updated = response
+				}
+
+				continue
+			}
+
+			response, newId, err := r.updateOnEndpoint(ctx, client, d, resourceId, request)
+			endpointIds[client.name] = newId
+
+			if err != nil {
+				r.setEndpointIds(d, targets, endpointIds)
+				return diag.FromErr(err)
+			}
+
+			if updated == nil {
+				updated = response
+			}
 		}
 
-		if err = r.updateResource(d, response); err != nil {
+		r.setEndpointIds(d, targets, endpointIds)
+
+		if err = r.updateResource(d, updated); err != nil {
 			return diag.FromErr(err)
 		}
 
@@ -272,38 +577,67 @@ func (r *resource[RequestType, ResponseType, IdType]) GetUpdateFunction() schema
 	}
 }
 
-func (r *resource[RequestType, ResponseType, IdType]) GetDeleteFunction() schema.DeleteContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-		client := m.(*pfsenseapi.Client)
+// updateOnEndpoint updates the item with the ID on an endpoint, and returns it with its ID which changes when items
+// identified by their position have moved.
+func (r *resource[RequestType, ResponseType, IdType]) updateOnEndpoint(ctx context.Context, client *apiClient, d *schema.ResourceData, resourceId string, request *RequestType) (*ResponseType, string, error) {
+	partition, id, err := r.parseResourceId(resourceId)
 
-		partition, id, err := r.getResourceId(d)
+	if err != nil {
+		return nil, resourceId, err
+	}
 
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	var response *ResponseType
 
-		if r.delete != nil {
-			err := r.delete(ctx, client, partition, id)
+	err = r.write(ctx, client, func() (err error) {
+		if len(r.positionKeys) > 0 {
+			var found bool
 
-			if err != nil {
-				return diag.FromErr(err)
+			if id, found, err = r.locateForWrite(ctx, client, d, partition, id); err != nil {
+				return err
 			}
-		} else {
-			if err := r.UpdateFromId(ctx, client, d); err != nil {
-				return diag.FromErr(err)
+
+			if !found {
+				return fmt.Errorf("%w with Id %s, it was changed or removed outside of Terraform. Refresh the state and apply again", errNotFound, fmt.Sprint(id))
 			}
+		}
 
-			request := new(RequestType)
+		response, err = r.update(ctx, client, id, request)
+		return err
+	})
 
-			if err := r.updateRequest(d, request); err != nil {
-				return diag.FromErr(err)
-			}
+	return response, r.formatId(partition, id), err
+}
 
-			if err := r.disable(request); err != nil {
-				return diag.FromErr(err)
-			}
+// setEndpointIds records the IDs of the resource on the endpoints it's on after an update, endpoints it isn't on are
+// left out so that it's created on them by the next apply.
+func (r *resource[RequestType, ResponseType, IdType]) setEndpointIds(d *schema.ResourceData, targets []*apiClient, endpointIds map[string]interface{}) {
+	for _, client := range targets {
+		if _, ok := endpointIds[client.name]; ok {
+			continue
+		}
+
+		if id, ok := endpointId(d, client); ok {
+			endpointIds[client.name] = id
+		}
+	}
+
+	if id, ok := endpointIds[targets[0].name].(string); ok {
+		d.SetId(id)
+	}
+
+	d.Set(targetIdsProperty, endpointIds)
+}
+
+func (r *resource[RequestType, ResponseType, IdType]) GetDeleteFunction() schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		targets, err := r.targets(d, m)
 
-			if _, err := r.update(ctx, client, id, request); err != nil {
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, client := range targets {
+			if err := r.deleteFromEndpoint(ctx, client, d); err != nil {
 				return diag.FromErr(err)
 			}
 		}
@@ -314,6 +648,57 @@ func (r *resource[RequestType, ResponseType, IdType]) GetDeleteFunction() schema
 	}
 }
 
+func (r *resource[RequestType, ResponseType, IdType]) deleteFromEndpoint(ctx context.Context, client *apiClient, d *schema.ResourceData) error {
+	resourceId, ok := endpointId(d, client)
+
+	// it was deleted outside of Terraform and hasn't been created again
+	if !ok {
+		return nil
+	}
+
+	partition, id, err := r.parseResourceId(resourceId)
+
+	if err != nil {
+		return err
+	}
+
+	if r.delete != nil {
+		return r.write(ctx, client, func() error {
+			if len(r.positionKeys) > 0 {
+				newId, found, err := r.locateForWrite(ctx, client, d, partition, id)
+
+				// there's nothing to delete when the item was already removed
+				if err != nil || !found {
+					return err
+				}
+
+				id = newId
+			}
+
+			return r.delete(ctx, client, partition, id)
+		})
+	}
+
+	if err := r.UpdateFromId(ctx, client, d); err != nil {
+		return err
+	}
+
+	request := new(RequestType)
+
+	if err := r.updateRequest(d, request); err != nil {
+		return err
+	}
+
+	if err := r.disable(request); err != nil {
+		return err
+	}
+
+	return r.write(ctx, client, func() error {
+		_, err := r.update(ctx, client, id, request)
+		return err
+	})
+}
+
 func (r *resource[RequestType, ResponseType, IdType]) GetDiffSupressFunction(property *resourceProperty[RequestType, ResponseType]) schema.SchemaDiffSuppressFunc {
 	if property.schema.Default == nil || property.schema.Default == "" {
 		return nil
@@ -324,20 +709,81 @@ func (r *resource[RequestType, ResponseType, IdType]) GetDiffSupressFunction(pro
 	}
 }
 
-func (r *resource[RequestType, ResponseType, IdType]) GetImporter() *schema.ResourceImporter {
-	return &schema.ResourceImporter{
-		StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-			client := m.(*pfsenseapi.Client)
+// GetCustomizeDiffFunction checks the endpoints support the resource and the properties set when planning, instead of
+// the API rejecting the change while applying. It also plans creating the resource again on endpoints it was deleted
+// from outside of Terraform.
+func (r *resource[RequestType, ResponseType, IdType]) GetCustomizeDiffFunction() schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if m == nil {
+			return nil
+		}
+
+		target, _ := d.Get(targetProperty).(string)
+		targets, err := m.(*apiClient).targets(target)
+
+		if err != nil {
+			return err
+		}
+
+		if err := r.planMissingEndpoints(d, targets); err != nil {
+			return err
+		}
 
-			if err := r.UpdateFromId(ctx, client, d); err != nil {
-				return nil, err
+		for _, client := range targets {
+			if r.minimumVersion != "" {
+				if err := client.requireAPIVersion(ctx, r.minimumVersion, r.name); err != nil {
+					return err
+				}
 			}
 
-			return []*schema.ResourceData{d}, nil
-		},
+			for name, prop := range r.properties {
+				if _, ok := d.GetOk(name); !ok || prop.minimumVersion == "" {
+					continue
+				}
+
+				if err := client.requireAPIVersion(ctx, prop.minimumVersion, fmt.Sprintf("%s on %s", name, r.name)); err != nil {
+					return err
+				}
+			}
+		}
+
+		if r.customizeDiff != nil {
+			return r.customizeDiff(ctx, d, m)
+		}
+
+		return nil
 	}
 }
 
+// planMissingEndpoints plans an update when the resource has no ID on one of its endpoints, which Read removes when
+// the resource was deleted from the endpoint. Resources that can't be updated are replaced instead.
+func (r *resource[RequestType, ResponseType, IdType]) planMissingEndpoints(d *schema.ResourceDiff, targets []*apiClient) error {
+	prior, _ := d.GetChange(targetIdsProperty)
+	ids, _ := prior.(map[string]interface{})
+
+	if d.Id() == "" || len(ids) == 0 {
+		return nil
+	}
+
+	for _, client := range targets {
+		if id, _ := ids[client.name].(string); id != "" {
+			continue
+		}
+
+		if err := d.SetNewComputed(targetIdsProperty); err != nil {
+			return err
+		}
+
+		if r.schemaResource.UpdateContext == nil {
+			return d.ForceNew(targetIdsProperty)
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
 func (r *resource[RequestType, ResponseType, IdType]) AddResource(provider *schema.Provider) {
 	_, exists := provider.ResourcesMap[r.name]
 
@@ -355,18 +801,52 @@ func (r *resource[RequestType, ResponseType, IdType]) AddResource(provider *sche
 		Description:   r.description,
 	}
 
-	var idName string
+	updatable := false
 
 	for name, property := range r.properties {
+		resource.Schema[name] = property.schema
+		resource.Schema[name].DiffSuppressFunc = r.GetDiffSupressFunction(property)
+		updatable = updatable || (!property.schema.ForceNew && (property.schema.Required || property.schema.Optional))
+	}
 
+	// Terraform replaces resources that have nothing to update
+	if !updatable {
+		resource.UpdateContext = nil
+	}
+
+	resource.Schema[targetProperty] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Endpoints the resource is applied to, the name of an endpoint of the provider or `all`. If not specified, the provider's `default_target_endpoint` is used. Drift is checked on every endpoint.",
+	}
+	resource.Schema[targetIdsProperty] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Description: "ID of the resource on each endpoint it's applied to.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	r.schemaResource = resource
+	resource.CustomizeDiff = r.GetCustomizeDiffFunction()
+
+	r.setup()
+
+	provider.ResourcesMap[r.name] = resource
+}
+
+// setup finds the partition and ID properties, it's shared between resources and data sources.
+func (r *resource[RequestType, ResponseType, IdType]) setup() {
+	var idName string
+
+	for name, property := range r.properties {
 		if property.idProperty {
 			idName = name
 		} else if property.partition {
 			r.partitionId = name
 		}
-
-		resource.Schema[name] = property.schema
-		resource.Schema[name].DiffSuppressFunc = r.GetDiffSupressFunction(property)
 	}
 
 	if idName != "" {
@@ -374,7 +854,7 @@ func (r *resource[RequestType, ResponseType, IdType]) AddResource(provider *sche
 			panic(fmt.Sprintf("Shouldn't have get ID function set and an id property, provider error on %s", r.name))
 		}
 
-		r.getId = func(_ context.Context, _ *pfsenseapi.Client, response *ResponseType) (IdType, error) {
+		r.getId = func(_ context.Context, _ *apiClient, response *ResponseType) (IdType, error) {
 			var zeroValue IdType
 			i, err := r.properties[idName].getFromResponse(response)
 
@@ -395,6 +875,4 @@ func (r *resource[RequestType, ResponseType, IdType]) AddResource(provider *sche
 			return id, nil
 		}
 	}
-
-	provider.ResourcesMap[r.name] = resource
 }
@@ -0,0 +1,130 @@
+package pfsense
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const applyId = "apply"
+
+type applyRequest struct {
+	Subsystems []string
+	Triggers   map[string]interface{}
+}
+
+// applyResult is what a pfsense_apply applied, pfSense has no record of it so it's only ever kept in the state. Pending
+// are the subsystems pfSense has marked as dirty when it's read.
+type applyResult struct {
+	Applied []string
+	Pending []string
+}
+
+func resourceApply() *resource[applyRequest, applyResult, string] {
+	apply := func(ctx context.Context, client *apiClient, request *applyRequest) (*applyResult, error) {
+		applied, err := applyPending(ctx, client, request.Subsystems)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &applyResult{Applied: applied}, nil
+	}
+
+	return &resource[applyRequest, applyResult, string]{
+		name:        "pfsense_apply",
+		description: "Applies pending changes, use it with `apply_mode = \"deferred\"` so that changes are applied once instead of after every change. Changes are applied when the resource is created or its `triggers` change. Deferred changes are marked as pending on pfSense, the same way its web interface marks changes that haven't been applied. Changes still pending when the resource is refreshed, e.g. because a run failed before applying them, are applied by the next run. Use `depends_on` to make it run after the resources it applies.",
+		confirm:     true,
+		delete: func(_ context.Context, _ *apiClient, _ string, _ string) error {
+			return nil
+		},
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*applyResult, error) {
+			pending, err := dirtySubsystems(ctx, client)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return []*applyResult{{Pending: pending}}, nil
+		},
+		update: func(ctx context.Context, client *apiClient, _ string, request *applyRequest) (*applyResult, error) {
+			return apply(ctx, client, request)
+		},
+		create: apply,
+		getId: func(_ context.Context, _ *apiClient, _ *applyResult) (string, error) {
+			return applyId, nil
+		},
+		// apply again when changes are left pending on pfSense, which triggers don't show
+		customizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			if pending, _ := d.Get("pending").([]interface{}); d.Id() != "" && len(pending) > 0 {
+				return d.SetNewComputed("applied")
+			}
+
+			return nil
+		},
+		properties: map[string]*resourceProperty[applyRequest, applyResult]{
+			"applied": {
+				schema: &schema.Schema{
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Subsystems that were applied the last time this resource was created or updated.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				getFromResponse: func(res *applyResult) (interface{}, error) {
+					return res.Applied, nil
+				},
+			},
+			"pending": {
+				schema: &schema.Schema{
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Subsystems with changes that are saved on pfSense but haven't been applied, they are applied by the next run.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				getFromResponse: func(res *applyResult) (interface{}, error) {
+					return res.Pending, nil
+				},
+			},
+			"subsystems": {
+				schema: &schema.Schema{
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Subsystems to apply even if there are no pending changes to them, one of `interfaces`, `routing`, `filter`, `unbound` or `dhcp`. Subsystems with pending changes are always applied. DHCP changes are always applied by pfSense as they are made, `dhcp` restarts the DHCP server.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(applySubsystems, false),
+					},
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *applyRequest) error {
+					subsystems, err := interfaceToStringArray(d.Get(name))
+
+					if err != nil {
+						return err
+					}
+
+					req.Subsystems = subsystems
+					return nil
+				},
+			},
+			"triggers": {
+				schema: &schema.Schema{
+					Type:        schema.TypeMap,
+					Required:    true,
+					Description: "Values that apply the pending changes whenever they change, e.g. `jsonencode(pfsense_firewall_rule.web)` so that changes are applied in the same run as they are made. The IDs of the resources only change when a resource is replaced, changes made by an update are then applied by the next run.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *applyRequest) error {
+					req.Triggers = d.Get(name).(map[string]interface{})
+					return nil
+				},
+			},
+		},
+	}
+}
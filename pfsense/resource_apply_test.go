@@ -0,0 +1,7 @@
+package pfsense
+
+func resourceApplyTest() resourceTest {
+	return &tfResourceTest[applyRequest, applyResult, string]{
+		resource: resourceApply(),
+	}
+}
@@ -0,0 +1,58 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceConfigBackup() *resource[configBackupRequest, configBackup, string] {
+	return &resource[configBackupRequest, configBackup, string]{
+		name:        "pfsense_config_backup",
+		description: "Named backup of the pfSense configuration, taken by writing the configuration with the description so that pfSense adds it to its configuration history. Use `depends_on` to take it before or after the changes it should capture, destroying it deletes the backup.",
+		delete: func(ctx context.Context, client *apiClient, _ string, id string) error {
+			return deleteConfigBackup(ctx, client, id)
+		},
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*configBackup, error) {
+			return listConfigBackups(ctx, client)
+		},
+		update: func(_ context.Context, _ *apiClient, id string, _ *configBackupRequest) (*configBackup, error) {
+			return nil, fmt.Errorf("Unable to update backup %s, backups can't be changed once taken", id)
+		},
+		create: func(ctx context.Context, client *apiClient, request *configBackupRequest) (*configBackup, error) {
+			return createConfigBackup(ctx, client, *request)
+		},
+		getId: func(_ context.Context, _ *apiClient, res *configBackup) (string, error) {
+			return strconv.Itoa(int(res.Time)), nil
+		},
+		properties: map[string]*resourceProperty[configBackupRequest, configBackup]{
+			"description": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Name of the backup shown in the configuration history.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *configBackupRequest) error {
+					req.Description = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *configBackup) (interface{}, error) {
+					return res.Description, nil
+				},
+			},
+			"time": {
+				schema: &schema.Schema{
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Unix time the backup was taken.",
+				},
+				getFromResponse: func(res *configBackup) (interface{}, error) {
+					return int(res.Time), nil
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,7 @@
+package pfsense
+
+func resourceConfigBackupTest() resourceTest {
+	return &tfResourceTest[configBackupRequest, configBackup, string]{
+		resource: resourceConfigBackup(),
+	}
+}
@@ -12,16 +12,17 @@ func resourceDHCPStaticMapping() *resource[pfsenseapi.DHCPStaticMappingRequest,
 	return &resource[pfsenseapi.DHCPStaticMappingRequest, pfsenseapi.DHCPStaticMapping, string]{
 		name:        "pfsense_dhcp_static_mapping",
 		description: "IPv4 DHCP Static Mapping ",
-		delete: func(ctx context.Context, client *pfsenseapi.Client, interfaceName string, mac string) error {
+		importKeys:  []string{"host_name", "ip_address"},
+		delete: func(ctx context.Context, client *apiClient, interfaceName string, mac string) error {
 			return client.DHCP.DeleteStaticMapping(ctx, interfaceName, mac)
 		},
-		list: func(ctx context.Context, client *pfsenseapi.Client, iface string) ([]*pfsenseapi.DHCPStaticMapping, error) {
+		list: func(ctx context.Context, client *apiClient, iface string) ([]*pfsenseapi.DHCPStaticMapping, error) {
 			return client.DHCP.ListStaticMappings(ctx, iface)
 		},
-		update: func(ctx context.Context, client *pfsenseapi.Client, macAddress string, request *pfsenseapi.DHCPStaticMappingRequest) (*pfsenseapi.DHCPStaticMapping, error) {
+		update: func(ctx context.Context, client *apiClient, macAddress string, request *pfsenseapi.DHCPStaticMappingRequest) (*pfsenseapi.DHCPStaticMapping, error) {
 			return client.DHCP.UpdateStaticMapping(ctx, macAddress, *request)
 		},
-		create: func(ctx context.Context, client *pfsenseapi.Client, request *pfsenseapi.DHCPStaticMappingRequest) (*pfsenseapi.DHCPStaticMapping, error) {
+		create: func(ctx context.Context, client *apiClient, request *pfsenseapi.DHCPStaticMappingRequest) (*pfsenseapi.DHCPStaticMapping, error) {
 			return client.DHCP.CreateStaticMapping(ctx, *request)
 		},
 		properties: map[string]*resourceProperty[pfsenseapi.DHCPStaticMappingRequest, pfsenseapi.DHCPStaticMapping]{
@@ -15,17 +15,17 @@ func resourceFirewallAlias() *resource[pfsenseapi.FirewallAliasRequest, pfsensea
 	return &resource[pfsenseapi.FirewallAliasRequest, pfsenseapi.FirewallAlias, string]{
 		name:        "pfsense_firewall_alias",
 		description: "Firewall Alias",
-		delete: func(ctx context.Context, client *pfsenseapi.Client, _ string, name string) error {
-			return client.Firewall.DeleteAlias(ctx, name, true)
+		delete: func(ctx context.Context, client *apiClient, _ string, name string) error {
+			return client.Firewall.DeleteAlias(ctx, name, shouldApply(client, applySubsystemFilter))
 		},
-		list: func(ctx context.Context, client *pfsenseapi.Client, _ string) ([]*pfsenseapi.FirewallAlias, error) {
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*pfsenseapi.FirewallAlias, error) {
 			return client.Firewall.ListAliases(ctx)
 		},
-		update: func(ctx context.Context, client *pfsenseapi.Client, name string, request *pfsenseapi.FirewallAliasRequest) (*pfsenseapi.FirewallAlias, error) {
-			return client.Firewall.UpdateAlias(ctx, name, *request, true)
+		update: func(ctx context.Context, client *apiClient, name string, request *pfsenseapi.FirewallAliasRequest) (*pfsenseapi.FirewallAlias, error) {
+			return client.Firewall.UpdateAlias(ctx, name, *request, shouldApply(client, applySubsystemFilter))
 		},
-		create: func(ctx context.Context, client *pfsenseapi.Client, request *pfsenseapi.FirewallAliasRequest) (*pfsenseapi.FirewallAlias, error) {
-			return client.Firewall.CreateAlias(ctx, *request, true)
+		create: func(ctx context.Context, client *apiClient, request *pfsenseapi.FirewallAliasRequest) (*pfsenseapi.FirewallAlias, error) {
+			return client.Firewall.CreateAlias(ctx, *request, shouldApply(client, applySubsystemFilter))
 		},
 		properties: map[string]*resourceProperty[pfsenseapi.FirewallAliasRequest, pfsenseapi.FirewallAlias]{
 			"name": {
@@ -13,19 +13,24 @@ func resourceFirewallRule() *resource[pfsenseapi.FirewallRuleRequest, pfsenseapi
 	return &resource[pfsenseapi.FirewallRuleRequest, pfsenseapi.FirewallRule, int]{
 		name:        "pfsense_firewall_rule",
 		description: "Firewall Rule",
-		delete: func(ctx context.Context, client *pfsenseapi.Client, _ string, id int) error {
-			return client.Firewall.DeleteRule(ctx, id, true)
+		importKeys:  []string{"description"},
+		confirm:     true,
+		delete: func(ctx context.Context, client *apiClient, _ string, id int) error {
+			return client.Firewall.DeleteRule(ctx, id, shouldApply(client, applySubsystemFilter))
 		},
-		list: func(ctx context.Context, client *pfsenseapi.Client, _ string) ([]*pfsenseapi.FirewallRule, error) {
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*pfsenseapi.FirewallRule, error) {
 			return client.Firewall.ListRules(ctx)
 		},
-		update: func(ctx context.Context, client *pfsenseapi.Client, id int, request *pfsenseapi.FirewallRuleRequest) (*pfsenseapi.FirewallRule, error) {
-			return client.Firewall.UpdateRule(ctx, id, *request, true)
+		get: func(ctx context.Context, client *apiClient, _ string, id int) (*pfsenseapi.FirewallRule, error) {
+			return getFirewallRule(ctx, client, id)
 		},
-		create: func(ctx context.Context, client *pfsenseapi.Client, request *pfsenseapi.FirewallRuleRequest) (*pfsenseapi.FirewallRule, error) {
-			return client.Firewall.CreateRule(ctx, *request, true)
+		update: func(ctx context.Context, client *apiClient, id int, request *pfsenseapi.FirewallRuleRequest) (*pfsenseapi.FirewallRule, error) {
+			return client.Firewall.UpdateRule(ctx, id, *request, shouldApply(client, applySubsystemFilter))
 		},
-		getId: func(_ context.Context, _ *pfsenseapi.Client, response *pfsenseapi.FirewallRule) (int, error) {
+		create: func(ctx context.Context, client *apiClient, request *pfsenseapi.FirewallRuleRequest) (*pfsenseapi.FirewallRule, error) {
+			return client.Firewall.CreateRule(ctx, *request, shouldApply(client, applySubsystemFilter))
+		},
+		getId: func(_ context.Context, _ *apiClient, response *pfsenseapi.FirewallRule) (int, error) {
 			return int(response.Tracker), nil
 		},
 		properties: map[string]*resourceProperty[pfsenseapi.FirewallRuleRequest, pfsenseapi.FirewallRule]{
@@ -33,7 +38,7 @@ func resourceFirewallRule() *resource[pfsenseapi.FirewallRuleRequest, pfsenseapi
 				schema: &schema.Schema{
 					Type:        schema.TypeString,
 					Optional:    true,
-					Description: "Acknowledge traffic shaper queue to apply to this rule. This must be an existing traffic shaper queue and cannot match the `defaultqueue` value.",
+					Description: "Acknowledge traffic shaper queue to apply to this rule. This must be an existing traffic shaper queue (see `pfsense_traffic_shaper_queue`) and cannot match the `defaultqueue` value.",
 				},
 				updateRequest: func(d *schema.ResourceData, name string, req *pfsenseapi.FirewallRuleRequest) error {
 					req.AckQueue = d.Get(name).(string)
@@ -47,7 +52,7 @@ func resourceFirewallRule() *resource[pfsenseapi.FirewallRuleRequest, pfsenseapi
 				schema: &schema.Schema{
 					Type:        schema.TypeString,
 					Optional:    true,
-					Description: "Default traffic shaper queue to apply to this rule. This must be an existing traffic shaper queue name. This field is required when an `ackqueue` value is provided.",
+					Description: "Default traffic shaper queue to apply to this rule. This must be an existing traffic shaper queue name (see `pfsense_traffic_shaper_queue`). This field is required when an `ackqueue` value is provided.",
 				},
 				updateRequest: func(d *schema.ResourceData, name string, req *pfsenseapi.FirewallRuleRequest) error {
 					req.DefaultQueue = d.Get(name).(string)
@@ -106,7 +111,7 @@ func resourceFirewallRule() *resource[pfsenseapi.FirewallRuleRequest, pfsenseapi
 				schema: &schema.Schema{
 					Type:        schema.TypeString,
 					Optional:    true,
-					Description: "Traffic shaper limiter (in) queue for this rule. This must be an existing traffic shaper limiter or queue. This field is required if a `pdnpipe` value is provided.",
+					Description: "Traffic shaper limiter (in) queue for this rule. This must be an existing traffic shaper limiter or queue (see `pfsense_traffic_shaper_limiter`). This field is required if a `pdnpipe` value is provided.",
 				},
 				updateRequest: func(d *schema.ResourceData, name string, req *pfsenseapi.FirewallRuleRequest) error {
 					req.DNPipe = d.Get(name).(string)
@@ -170,10 +175,9 @@ func resourceFirewallRule() *resource[pfsenseapi.FirewallRuleRequest, pfsenseapi
 			},
 			"gateway": {
 				schema: &schema.Schema{
-					Type:         schema.TypeString,
-					Optional:     true,
-					Description:  "Name of an existing gateway traffic will route over upon match. Do not specify this parameter to assume the default gateway. The gateway specified must be of the same IP type set in `ipprotocol`.",
-					ValidateFunc: validation.IsIPAddress,
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of an existing gateway or gateway group traffic will route over upon match. Do not specify this parameter to assume the default gateway. The gateway specified must be of the same IP type set in `ipprotocol`.",
 				},
 				updateRequest: func(d *schema.ResourceData, name string, req *pfsenseapi.FirewallRuleRequest) error {
 					req.Gateway = d.Get(name).(string)
@@ -265,7 +269,7 @@ func resourceFirewallRule() *resource[pfsenseapi.FirewallRuleRequest, pfsenseapi
 				schema: &schema.Schema{
 					Type:        schema.TypeString,
 					Optional:    true,
-					Description: "Traffic shaper limiter (out) queue for this rule. This must be an existing traffic shaper limiter or queue. This value cannot match the `dnpipe` value and must be a child queue if `dnpipe` is a child queue, or a parent limiter if `dnpipe` is a parent limiter.",
+					Description: "Traffic shaper limiter (out) queue for this rule. This must be an existing traffic shaper limiter or queue (see `pfsense_traffic_shaper_limiter`). This value cannot match the `dnpipe` value and must be a child queue if `dnpipe` is a child queue, or a parent limiter if `dnpipe` is a parent limiter.",
 				},
 				updateRequest: func(d *schema.ResourceData, name string, req *pfsenseapi.FirewallRuleRequest) error {
 					req.PDNPipe = d.Get(name).(string)
@@ -310,7 +314,7 @@ func resourceFirewallRule() *resource[pfsenseapi.FirewallRuleRequest, pfsenseapi
 				schema: &schema.Schema{
 					Type:        schema.TypeString,
 					Optional:    true,
-					Description: "Firewall schedule to apply to this rule. This must be an existing firewall schedule name.",
+					Description: "Firewall schedule to apply to this rule. This must be an existing firewall schedule name, such as the name of a `pfsense_firewall_schedule`.",
 				},
 				updateRequest: func(d *schema.ResourceData, name string, req *pfsenseapi.FirewallRuleRequest) error {
 					req.Sched = d.Get(name).(string)
@@ -0,0 +1,69 @@
+package pfsense
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFirewallRuleOrder() *resource[firewallRuleOrderRequest, firewallRuleOrder, string] {
+	return &resource[firewallRuleOrderRequest, firewallRuleOrder, string]{
+		name:        "pfsense_firewall_rule_order",
+		description: "Firewall Rule Order of an interface, pfSense evaluates rules from the top down. Any rule added to the interface or moved is reported as a change, destroying this resource leaves the rules in place.",
+		confirm:     true,
+		delete: func(_ context.Context, _ *apiClient, _ string, _ string) error {
+			return nil
+		},
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*firewallRuleOrder, error) {
+			return listFirewallRuleOrders(ctx, client)
+		},
+		update: func(ctx context.Context, client *apiClient, _ string, request *firewallRuleOrderRequest) (*firewallRuleOrder, error) {
+			return updateFirewallRuleOrder(ctx, client, *request, shouldApply(client, applySubsystemFilter))
+		},
+		create: func(ctx context.Context, client *apiClient, request *firewallRuleOrderRequest) (*firewallRuleOrder, error) {
+			return updateFirewallRuleOrder(ctx, client, *request, shouldApply(client, applySubsystemFilter))
+		},
+		properties: map[string]*resourceProperty[firewallRuleOrderRequest, firewallRuleOrder]{
+			"interface": {
+				idProperty: true,
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "pfSense interface ID (e.g. wan, lan, optx) the rules belong to.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *firewallRuleOrderRequest) error {
+					req.Interface = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *firewallRuleOrder) (interface{}, error) {
+					return res.Interface, nil
+				},
+			},
+			"rules": {
+				schema: &schema.Schema{
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Description: "Tracker IDs of every rule on the interface in the order they should be evaluated, e.g. `pfsense_firewall_rule.example.id`. Floating rules aren't included. Applying fails when a rule on the interface is left out, including rules created for port forwards.",
+					Elem: &schema.Schema{
+						Type: schema.TypeInt,
+					},
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *firewallRuleOrderRequest) error {
+					rules, err := interfaceToIntArray(d.Get(name))
+
+					if err != nil {
+						return err
+					}
+
+					req.Rules = rules
+					return nil
+				},
+				getFromResponse: func(res *firewallRuleOrder) (interface{}, error) {
+					return res.Rules, nil
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+func resourceFirewallRuleOrderTest() resourceTest {
+	return &tfResourceTest[firewallRuleOrderRequest, firewallRuleOrder, string]{
+		resource: resourceFirewallRuleOrder(),
+	}
+}
+
+func Test_FirewallRuleOrderCoversEveryRule(t *testing.T) {
+	tests := map[string]struct {
+		rules []int
+		err   string
+		moves int
+	}{
+		"every":     {rules: []int{3, 1, 2}, moves: 3},
+		"subset":    {rules: []int{2, 1}, err: "rules 3 are on the interface but not in rules"},
+		"duplicate": {rules: []int{3, 1, 1, 2}, err: "rule 1 is listed more than once"},
+		"unknown":   {rules: []int{3, 1, 2, 4}, err: "Unable to find rule 4 on interface lan"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var lock sync.Mutex
+			moves := 0
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPut {
+					lock.Lock()
+					moves++
+					lock.Unlock()
+				}
+
+				fmt.Fprint(w, `{"status": "ok", "code": 200, "return": 0, "message": "Success", "data": [
+					{"tracker": 1, "interface": "lan"},
+					{"tracker": 2, "interface": "lan"},
+					{"tracker": 5, "interface": "wan"},
+					{"tracker": 3, "interface": "lan"}
+				]}`)
+			}))
+
+			defer server.Close()
+
+			client := testAPIClient(t, pfsenseapi.Config{Host: server.URL, LocalAuthEnabled: true}, apiClientSettings{})
+			_, err := updateFirewallRuleOrder(context.Background(), client, firewallRuleOrderRequest{Interface: "lan", Rules: test.rules}, false)
+
+			if test.err == "" && err != nil {
+				t.Errorf("Unexpected error %v", err)
+			} else if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+				t.Errorf("Expected an error containing %q but found %v", test.err, err)
+			}
+
+			if moves != test.moves {
+				t.Errorf("Expected %d rules to be moved but found %d", test.moves, moves)
+			}
+		})
+	}
+}
@@ -1,6 +1,12 @@
 package pfsense
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
 	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
 )
 
@@ -9,3 +15,19 @@ func resourceFirewallRuleTest() resourceTest {
 		resource: resourceFirewallRule(),
 	}
 }
+
+func Test_DeletedFirewallRuleIsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status": "not found", "code": 404, "return": 1, "message": "Object not found", "data": []}`)
+	}))
+
+	defer server.Close()
+
+	client := testAPIClient(t, pfsenseapi.Config{Host: server.URL, LocalAuthEnabled: true}, apiClientSettings{})
+	rule, err := getFirewallRule(context.Background(), client, 1690000000)
+
+	if err != nil || rule != nil {
+		t.Errorf("Expected the rule not to be found but got %v and %v", rule, err)
+	}
+}
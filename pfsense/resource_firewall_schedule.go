@@ -0,0 +1,209 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const scheduleListSeparator = ","
+const scheduleHourSeparator = "-"
+
+var scheduleTimeValidator schema.SchemaValidateFunc = validation.StringMatch(regexValidator(`^([01]?[0-9]|2[0-3]):(00|15|30|45|59)$`), "Time must be in the format HH:MM using 15 minute increments or 23:59")
+
+func resourceFirewallSchedule() *resource[firewallScheduleRequest, firewallSchedule, string] {
+	return &resource[firewallScheduleRequest, firewallSchedule, string]{
+		name:        "pfsense_firewall_schedule",
+		description: "Firewall Schedule",
+		indexed:     true,
+		delete: func(ctx context.Context, client *apiClient, _ string, name string) error {
+			return deleteFirewallSchedule(ctx, client, name, shouldApply(client, applySubsystemFilter))
+		},
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*firewallSchedule, error) {
+			return listFirewallSchedules(ctx, client)
+		},
+		update: func(ctx context.Context, client *apiClient, name string, request *firewallScheduleRequest) (*firewallSchedule, error) {
+			return updateFirewallSchedule(ctx, client, name, *request, shouldApply(client, applySubsystemFilter))
+		},
+		create: func(ctx context.Context, client *apiClient, request *firewallScheduleRequest) (*firewallSchedule, error) {
+			return createFirewallSchedule(ctx, client, *request, shouldApply(client, applySubsystemFilter))
+		},
+		properties: map[string]*resourceProperty[firewallScheduleRequest, firewallSchedule]{
+			"description": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Description for the schedule.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *firewallScheduleRequest) error {
+					req.Descr = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *firewallSchedule) (interface{}, error) {
+					return res.Descr, nil
+				},
+			},
+			"name": {
+				idProperty: true,
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "Name of the schedule, this is the value `pfsense_firewall_rule.schedule` refers to. Only alpha-numeric and underscore characters are allowed.",
+					ValidateFunc: validation.StringMatch(regexValidator(`^\w+$`), "Only alpha-numeric and underscore characters are allowed"),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *firewallScheduleRequest) error {
+					req.Name = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *firewallSchedule) (interface{}, error) {
+					return res.Name, nil
+				},
+			},
+			"time_range": {
+				schema: &schema.Schema{
+					Type:        schema.TypeList,
+					Required:    true,
+					Description: "Time ranges the schedule is active for. Each time range applies either to specific dates using `months` and `days`, or to every week using `weekdays`.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"days": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Days of the month this time range applies to, each day is in the month at the same position in `months`.",
+								Elem: &schema.Schema{
+									Type:         schema.TypeInt,
+									ValidateFunc: validation.IntBetween(1, 31),
+								},
+							},
+							"description": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Description for the time range.",
+							},
+							"months": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Months this time range applies to, where January is 1. There must be one entry for each entry in `days`.",
+								Elem: &schema.Schema{
+									Type:         schema.TypeInt,
+									ValidateFunc: validation.IntBetween(1, 12),
+								},
+							},
+							"start_hour": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Time of day the time range starts in the format HH:MM.",
+								ValidateFunc: scheduleTimeValidator,
+							},
+							"stop_hour": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Time of day the time range stops in the format HH:MM. This must be later than `start_hour`.",
+								ValidateFunc: scheduleTimeValidator,
+							},
+							"weekdays": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Days of the week this time range applies to every week, where Monday is 1 and Sunday is 7. This cannot be combined with `months` and `days`.",
+								Elem: &schema.Schema{
+									Type:         schema.TypeInt,
+									ValidateFunc: validation.IntBetween(1, 7),
+								},
+							},
+						},
+					},
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *firewallScheduleRequest) error {
+					timeRanges := d.Get(name).([]interface{})
+					req.TimeRange = make([]*firewallScheduleTimeRange, len(timeRanges))
+
+					for i, timeRange := range timeRanges {
+						timeRangeMap := timeRange.(map[string]interface{})
+
+						months, err := interfaceToIntArray(timeRangeMap["months"])
+
+						if err != nil {
+							return err
+						}
+
+						days, err := interfaceToIntArray(timeRangeMap["days"])
+
+						if err != nil {
+							return err
+						}
+
+						weekdays, err := interfaceToIntArray(timeRangeMap["weekdays"])
+
+						if err != nil {
+							return err
+						}
+
+						if len(months) != len(days) {
+							return fmt.Errorf("Time range %d of %s has %d months and %d days, there must be a month for each day", i, name, len(months), len(days))
+						}
+
+						if len(weekdays) == 0 && len(days) == 0 {
+							return fmt.Errorf("Time range %d of %s must have either weekdays or days set", i, name)
+						} else if len(weekdays) > 0 && len(days) > 0 {
+							return fmt.Errorf("Time range %d of %s cannot have both weekdays and days set", i, name)
+						}
+
+						req.TimeRange[i] = &firewallScheduleTimeRange{
+							Month:      joinInts(months, scheduleListSeparator),
+							Day:        joinInts(days, scheduleListSeparator),
+							Position:   joinInts(weekdays, scheduleListSeparator),
+							Hour:       timeRangeMap["start_hour"].(string) + scheduleHourSeparator + timeRangeMap["stop_hour"].(string),
+							RangeDescr: timeRangeMap["description"].(string),
+						}
+					}
+
+					return nil
+				},
+				getFromResponse: func(res *firewallSchedule) (interface{}, error) {
+					timeRanges := make([]interface{}, len(res.TimeRange))
+
+					for i, timeRange := range res.TimeRange {
+						months, err := splitIntoIntArray(timeRange.Month, scheduleListSeparator)
+
+						if err != nil {
+							return nil, err
+						}
+
+						days, err := splitIntoIntArray(timeRange.Day, scheduleListSeparator)
+
+						if err != nil {
+							return nil, err
+						}
+
+						weekdays, err := splitIntoIntArray(timeRange.Position, scheduleListSeparator)
+
+						if err != nil {
+							return nil, err
+						}
+
+						hours := strings.SplitN(timeRange.Hour, scheduleHourSeparator, 2)
+
+						if len(hours) != 2 {
+							return nil, fmt.Errorf("Unable to parse hours %s of schedule %s", timeRange.Hour, res.Name)
+						}
+
+						timeRanges[i] = map[string]interface{}{
+							"months":      months,
+							"days":        days,
+							"weekdays":    weekdays,
+							"start_hour":  hours[0],
+							"stop_hour":   hours[1],
+							"description": timeRange.RangeDescr,
+						}
+					}
+
+					return timeRanges, nil
+				},
+			},
+		},
+	}
+}
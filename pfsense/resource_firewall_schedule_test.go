@@ -0,0 +1,7 @@
+package pfsense
+
+func resourceFirewallScheduleTest() resourceTest {
+	return &tfResourceTest[firewallScheduleRequest, firewallSchedule, string]{
+		resource: resourceFirewallSchedule(),
+	}
+}
@@ -3,6 +3,7 @@ package pfsense
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,18 +14,20 @@ func resourceInterface() *resource[pfsenseapi.InterfaceRequest, pfsenseapi.Inter
 	r := &resource[pfsenseapi.InterfaceRequest, pfsenseapi.Interface, string]{
 		name:        "pfsense_interface",
 		description: "Interface",
-		delete: func(ctx context.Context, client *pfsenseapi.Client, _ string, id string) error {
+		importKeys:  []string{"description"},
+		confirm:     true,
+		delete: func(ctx context.Context, client *apiClient, _ string, id string) error {
 			return client.Interface.DeleteInterface(ctx, id)
 		},
-		list: func(ctx context.Context, client *pfsenseapi.Client, _ string) ([]*pfsenseapi.Interface, error) {
-			return client.Interface.ListInterfaces(ctx)
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*pfsenseapi.Interface, error) {
+			return listInterfaces(ctx, client)
 		},
-		update: func(ctx context.Context, client *pfsenseapi.Client, id string, request *pfsenseapi.InterfaceRequest) (*pfsenseapi.Interface, error) {
-			request.Apply = true
+		update: func(ctx context.Context, client *apiClient, id string, request *pfsenseapi.InterfaceRequest) (*pfsenseapi.Interface, error) {
+			request.Apply = shouldApply(client, applySubsystemInterfaces)
 			return client.Interface.UpdateInterface(ctx, id, *request)
 		},
-		create: func(ctx context.Context, client *pfsenseapi.Client, request *pfsenseapi.InterfaceRequest) (*pfsenseapi.Interface, error) {
-			request.Apply = true
+		create: func(ctx context.Context, client *apiClient, request *pfsenseapi.InterfaceRequest) (*pfsenseapi.Interface, error) {
+			request.Apply = shouldApply(client, applySubsystemInterfaces)
 			return client.Interface.CreateInterface(ctx, *request)
 		},
 		properties: map[string]*resourceProperty[pfsenseapi.InterfaceRequest, pfsenseapi.Interface]{
@@ -659,7 +662,7 @@ func resourceInterface() *resource[pfsenseapi.InterfaceRequest, pfsenseapi.Inter
 		},
 	}
 
-	r.getId = func(ctx context.Context, client *pfsenseapi.Client, i *pfsenseapi.Interface) (string, error) {
+	r.getId = func(ctx context.Context, client *apiClient, i *pfsenseapi.Interface) (string, error) {
 		ifaces, err := r.list(ctx, client, "")
 
 		if err != nil {
@@ -677,3 +680,17 @@ func resourceInterface() *resource[pfsenseapi.InterfaceRequest, pfsenseapi.Inter
 
 	return r
 }
+
+func listInterfaces(ctx context.Context, client *apiClient) ([]*pfsenseapi.Interface, error) {
+	list, err := client.Interface.ListInterfaces(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list, nil
+}
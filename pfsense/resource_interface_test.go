@@ -1,6 +1,12 @@
 package pfsense
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
 	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
 )
 
@@ -9,3 +15,32 @@ func resourceInterfaceTest() resourceTest {
 		resource: resourceInterface(),
 	}
 }
+
+func Test_InterfacesAreSortedByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"status":"ok","code":200,"return":0,"message":"Success","data":{"wan":{"if":"igb0"},"opt2":{"if":"igb3"},"lan":{"if":"igb1"},"opt1":{"if":"igb2"}}}`)
+	}))
+
+	t.Cleanup(server.Close)
+
+	client := testAPIClient(t, pfsenseapi.Config{Host: server.URL}, apiClientSettings{})
+
+	for i := 0; i < 10; i++ {
+		ifaces, err := listInterfaces(context.Background(), client)
+
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		names := make([]string, 0, len(ifaces))
+
+		for _, iface := range ifaces {
+			names = append(names, iface.Name)
+		}
+
+		if fmt.Sprint(names) != "[lan opt1 opt2 wan]" {
+			t.Fatalf("Expected interfaces to be sorted by name but found %v", names)
+		}
+	}
+}
@@ -12,19 +12,20 @@ func resourceInterfaceVLAN() *resource[pfsenseapi.VLANRequest, pfsenseapi.VLAN,
 	return &resource[pfsenseapi.VLANRequest, pfsenseapi.VLAN, string]{
 		name:        "pfsense_interface_vlan",
 		description: "VLAN",
-		delete: func(ctx context.Context, client *pfsenseapi.Client, _ string, id string) error {
+		importKeys:  []string{"description"},
+		delete: func(ctx context.Context, client *apiClient, _ string, id string) error {
 			return client.Interface.DeleteVLAN(ctx, id)
 		},
-		list: func(ctx context.Context, client *pfsenseapi.Client, _ string) ([]*pfsenseapi.VLAN, error) {
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*pfsenseapi.VLAN, error) {
 			return client.Interface.ListVLANs(ctx)
 		},
-		update: func(ctx context.Context, client *pfsenseapi.Client, id string, request *pfsenseapi.VLANRequest) (*pfsenseapi.VLAN, error) {
+		update: func(ctx context.Context, client *apiClient, id string, request *pfsenseapi.VLANRequest) (*pfsenseapi.VLAN, error) {
 			return client.Interface.UpdateVLAN(ctx, id, *request)
 		},
-		create: func(ctx context.Context, client *pfsenseapi.Client, request *pfsenseapi.VLANRequest) (*pfsenseapi.VLAN, error) {
+		create: func(ctx context.Context, client *apiClient, request *pfsenseapi.VLANRequest) (*pfsenseapi.VLAN, error) {
 			return client.Interface.CreateVLAN(ctx, *request)
 		},
-		getId: func(_ context.Context, _ *pfsenseapi.Client, response *pfsenseapi.VLAN) (string, error) {
+		getId: func(_ context.Context, _ *apiClient, response *pfsenseapi.VLAN) (string, error) {
 			return response.Vlanif, nil
 		},
 		properties: map[string]*resourceProperty[pfsenseapi.VLANRequest, pfsenseapi.VLAN]{
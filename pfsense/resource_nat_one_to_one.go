@@ -0,0 +1,161 @@
+package pfsense
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNATOneToOne() *resource[natOneToOneRequest, natOneToOne, string] {
+	return &resource[natOneToOneRequest, natOneToOne, string]{
+		name:         "pfsense_nat_one_to_one",
+		description:  "1:1 NAT Mapping",
+		indexed:      true,
+		positionKeys: []string{"interface", "external", "internal", "destination"},
+		importKeys:   []string{"description"},
+		delete: func(ctx context.Context, client *apiClient, _ string, id string) error {
+			return deleteNATOneToOne(ctx, client, id, shouldApply(client, applySubsystemFilter))
+		},
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*natOneToOne, error) {
+			return listNATOneToOnes(ctx, client)
+		},
+		update: func(ctx context.Context, client *apiClient, id string, request *natOneToOneRequest) (*natOneToOne, error) {
+			return updateNATOneToOne(ctx, client, id, *request, shouldApply(client, applySubsystemFilter))
+		},
+		create: func(ctx context.Context, client *apiClient, request *natOneToOneRequest) (*natOneToOne, error) {
+			return createNATOneToOne(ctx, client, *request, shouldApply(client, applySubsystemFilter))
+		},
+		getId: func(_ context.Context, _ *apiClient, response *natOneToOne) (string, error) {
+			return response.Id, nil
+		},
+		properties: map[string]*resourceProperty[natOneToOneRequest, natOneToOne]{
+			"description": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Description for the mapping.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOneToOneRequest) error {
+					req.Descr = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOneToOne) (interface{}, error) {
+					return res.Descr, nil
+				},
+			},
+			"destination": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "any",
+					Description: "Destination address the mapping applies to. This may be a single IP, network CIDR or alias name. To negate the context of the destination address, you may prefix the value with `!`.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOneToOneRequest) error {
+					req.Dst = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOneToOne) (interface{}, error) {
+					if res.Destination == nil {
+						return nil, nil
+					}
+
+					return res.Destination.TargetString(), nil
+				},
+			},
+			"disabled": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Disable the mapping.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOneToOneRequest) error {
+					req.Disabled = d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *natOneToOne) (interface{}, error) {
+					return bool(res.Disabled), nil
+				},
+			},
+			"external": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "External address the internal subnet is mapped to. The size of the external subnet is taken from `internal`.",
+					ValidateFunc: validation.IsIPAddress,
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOneToOneRequest) error {
+					req.External = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOneToOne) (interface{}, error) {
+					return res.External, nil
+				},
+			},
+			"interface": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Interface this mapping will apply to. You may specify either the interface's descriptive name, the pfSense interface ID (e.g. wan, lan, optx), or the real interface ID (e.g. igb0).",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOneToOneRequest) error {
+					req.Interface = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOneToOne) (interface{}, error) {
+					return res.Interface, nil
+				},
+			},
+			"internal": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Internal address or network CIDR to map to `external`.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOneToOneRequest) error {
+					req.Src = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOneToOne) (interface{}, error) {
+					if res.Source == nil {
+						return nil, nil
+					}
+
+					return res.Source.TargetString(), nil
+				},
+			},
+			"ip_protocol": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "inet",
+					Description:  "IP protocol this mapping will apply to.",
+					ValidateFunc: validation.StringInSlice([]string{"inet", "inet6"}, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOneToOneRequest) error {
+					req.IPProtocol = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOneToOne) (interface{}, error) {
+					return res.IPProtocol, nil
+				},
+			},
+			"nat_reflection": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "NAT reflection mode for this mapping. If not specified, the system default is used.",
+					ValidateFunc: validation.StringInSlice([]string{"enable", "disable"}, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOneToOneRequest) error {
+					req.NATReflection = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOneToOne) (interface{}, error) {
+					return res.NATReflection, nil
+				},
+			},
+		},
+	}
+}
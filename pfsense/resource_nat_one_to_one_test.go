@@ -0,0 +1,7 @@
+package pfsense
+
+func resourceNATOneToOneTest() resourceTest {
+	return &tfResourceTest[natOneToOneRequest, natOneToOne, string]{
+		resource: resourceNATOneToOne(),
+	}
+}
@@ -0,0 +1,240 @@
+package pfsense
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNATOutboundMapping() *resource[natOutboundMappingRequest, natOutboundMapping, string] {
+	return &resource[natOutboundMappingRequest, natOutboundMapping, string]{
+		name:         "pfsense_nat_outbound_mapping",
+		description:  "Outbound NAT Mapping, these are only used when `pfsense_nat_outbound_mode` is `hybrid` or `manual`.",
+		indexed:      true,
+		positionKeys: []string{"interface", "protocol", "source", "source_port", "destination", "destination_port"},
+		importKeys:   []string{"description"},
+		delete: func(ctx context.Context, client *apiClient, _ string, id string) error {
+			return deleteNATOutboundMapping(ctx, client, id, shouldApply(client, applySubsystemFilter))
+		},
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*natOutboundMapping, error) {
+			return listNATOutboundMappings(ctx, client)
+		},
+		update: func(ctx context.Context, client *apiClient, id string, request *natOutboundMappingRequest) (*natOutboundMapping, error) {
+			return updateNATOutboundMapping(ctx, client, id, *request, shouldApply(client, applySubsystemFilter))
+		},
+		create: func(ctx context.Context, client *apiClient, request *natOutboundMappingRequest) (*natOutboundMapping, error) {
+			return createNATOutboundMapping(ctx, client, *request, shouldApply(client, applySubsystemFilter))
+		},
+		getId: func(_ context.Context, _ *apiClient, response *natOutboundMapping) (string, error) {
+			return response.Id, nil
+		},
+		properties: map[string]*resourceProperty[natOutboundMappingRequest, natOutboundMapping]{
+			"description": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Description for the mapping.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundMappingRequest) error {
+					req.Descr = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMapping) (interface{}, error) {
+					return res.Descr, nil
+				},
+			},
+			"destination": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "any",
+					Description: "Destination address of the mapping. This may be a single IP, network CIDR or alias name. To negate the context of the destination address, you may prefix the value with `!`.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundMappingRequest) error {
+					req.Dst = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMapping) (interface{}, error) {
+					if res.Destination == nil {
+						return nil, nil
+					}
+
+					return res.Destination.TargetString(), nil
+				},
+			},
+			"destination_port": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "TCP and/or UDP destination port or port range to match. This parameter is only available when `protocol` is set to `tcp`, `udp`, or `tcp/udp`.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundMappingRequest) error {
+					req.DstPort = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMapping) (interface{}, error) {
+					if res.Destination == nil {
+						return nil, nil
+					}
+
+					return res.Destination.Port, nil
+				},
+			},
+			"disabled": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Disable the mapping.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundMappingRequest) error {
+					req.Disabled = d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMapping) (interface{}, error) {
+					return bool(res.Disabled), nil
+				},
+			},
+			"interface": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Interface traffic will leave through for this mapping to apply. You may specify either the interface's descriptive name, the pfSense interface ID (e.g. wan, lan, optx), or the real interface ID (e.g. igb0).",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundMappingRequest) error {
+					req.Interface = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMapping) (interface{}, error) {
+					return res.Interface, nil
+				},
+			},
+			"no_nat": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Disable NAT for traffic matching this mapping.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundMappingRequest) error {
+					req.NoNAT = d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMapping) (interface{}, error) {
+					return bool(res.NoNAT), nil
+				},
+			},
+			"pool_options": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Pool option used when `translation_address` is a subnet or alias containing multiple addresses. If not specified, `round-robin` is used.",
+					ValidateFunc: validation.StringInSlice([]string{"round-robin", "round-robin sticky-address", "random", "random sticky-address", "source-hash", "bitmask"}, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundMappingRequest) error {
+					req.PoolOpts = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMapping) (interface{}, error) {
+					return res.PoolOpts, nil
+				},
+			},
+			"protocol": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "any",
+					Description:  "Transfer protocol this mapping will apply to.",
+					ValidateFunc: validation.StringInSlice([]string{"any", "tcp", "udp", "tcp/udp", "icmp", "esp", "ah", "gre", "ipv6", "igmp", "pim", "ospf"}, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundMappingRequest) error {
+					req.Protocol = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMapping) (interface{}, error) {
+					return res.Protocol, nil
+				},
+			},
+			"source": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Source address of the mapping. This may be a single IP, network CIDR or alias name. To negate the context of the source address, you may prefix the value with `!`.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundMappingRequest) error {
+					req.Src = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMapping) (interface{}, error) {
+					if res.Source == nil {
+						return nil, nil
+					}
+
+					return res.Source.TargetString(), nil
+				},
+			},
+			"source_port": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "TCP and/or UDP source port or port range to match. This parameter is only available when `protocol` is set to `tcp`, `udp`, or `tcp/udp`.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundMappingRequest) error {
+					req.SrcPort = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMapping) (interface{}, error) {
+					if res.Source == nil {
+						return nil, nil
+					}
+
+					return res.Source.Port, nil
+				},
+			},
+			"static_port": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Keep the source port of translated traffic unchanged.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundMappingRequest) error {
+					req.StaticNATPort = d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMapping) (interface{}, error) {
+					return bool(res.StaticNATPort), nil
+				},
+			},
+			"translation_address": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Address, subnet or alias to translate matching traffic to. If not specified, the address of `interface` is used.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundMappingRequest) error {
+					req.Target = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMapping) (interface{}, error) {
+					return res.Target, nil
+				},
+			},
+			"translation_port": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Port or port range to translate the source port of matching traffic to. This parameter is only available when `static_port` is `false`.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundMappingRequest) error {
+					req.NATPort = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMapping) (interface{}, error) {
+					return res.NATPort, nil
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,7 @@
+package pfsense
+
+func resourceNATOutboundMappingTest() resourceTest {
+	return &tfResourceTest[natOutboundMappingRequest, natOutboundMapping, string]{
+		resource: resourceNATOutboundMapping(),
+	}
+}
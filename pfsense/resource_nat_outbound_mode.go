@@ -0,0 +1,62 @@
+package pfsense
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNATOutboundMode() *resource[natOutboundModeRequest, natOutboundMode, string] {
+	return &resource[natOutboundModeRequest, natOutboundMode, string]{
+		name:        "pfsense_nat_outbound_mode",
+		description: "Outbound NAT Mode, there is only one per pfSense instance. Destroying this resource returns the mode to `automatic`.",
+		disable: func(request *natOutboundModeRequest) error {
+			request.Mode = "automatic"
+			return nil
+		},
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*natOutboundMode, error) {
+			mode, err := getNATOutboundMode(ctx, client)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return []*natOutboundMode{mode}, nil
+		},
+		update: func(ctx context.Context, client *apiClient, _ string, request *natOutboundModeRequest) (*natOutboundMode, error) {
+			return updateNATOutboundMode(ctx, client, *request, shouldApply(client, applySubsystemFilter))
+		},
+		create: func(ctx context.Context, client *apiClient, request *natOutboundModeRequest) (*natOutboundMode, error) {
+			return updateNATOutboundMode(ctx, client, *request, shouldApply(client, applySubsystemFilter))
+		},
+		getId: func(_ context.Context, _ *apiClient, _ *natOutboundMode) (string, error) {
+			return natOutboundModeId, nil
+		},
+		properties: map[string]*resourceProperty[natOutboundModeRequest, natOutboundMode]{
+			"mode": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Outbound NAT mode. `automatic` generates mappings for every interface, `hybrid` uses `pfsense_nat_outbound_mapping` resources before the automatic mappings, `manual` only uses `pfsense_nat_outbound_mapping` resources and `disabled` turns off outbound NAT.",
+					ValidateFunc: validation.StringInSlice([]string{"automatic", "hybrid", "manual", "disabled"}, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natOutboundModeRequest) error {
+					// pfSense calls manual outbound NAT advanced
+					if req.Mode = d.Get(name).(string); req.Mode == "manual" {
+						req.Mode = "advanced"
+					}
+
+					return nil
+				},
+				getFromResponse: func(res *natOutboundMode) (interface{}, error) {
+					if res.Mode == "advanced" {
+						return "manual", nil
+					}
+
+					return res.Mode, nil
+				},
+			},
+		},
+	}
+}
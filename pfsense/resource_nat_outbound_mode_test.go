@@ -0,0 +1,7 @@
+package pfsense
+
+func resourceNATOutboundModeTest() resourceTest {
+	return &tfResourceTest[natOutboundModeRequest, natOutboundMode, string]{
+		resource: resourceNATOutboundMode(),
+	}
+}
@@ -0,0 +1,237 @@
+package pfsense
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNATPortForward() *resource[natPortForwardRequest, natPortForward, string] {
+	return &resource[natPortForwardRequest, natPortForward, string]{
+		name:         "pfsense_nat_port_forward",
+		description:  "NAT Port Forward",
+		indexed:      true,
+		positionKeys: []string{"interface", "protocol", "destination", "destination_port"},
+		importKeys:   []string{"description"},
+		delete: func(ctx context.Context, client *apiClient, _ string, id string) error {
+			return deleteNATPortForward(ctx, client, id, shouldApply(client, applySubsystemFilter))
+		},
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*natPortForward, error) {
+			return listNATPortForwards(ctx, client)
+		},
+		update: func(ctx context.Context, client *apiClient, id string, request *natPortForwardRequest) (*natPortForward, error) {
+			return updateNATPortForward(ctx, client, id, *request, shouldApply(client, applySubsystemFilter))
+		},
+		create: func(ctx context.Context, client *apiClient, request *natPortForwardRequest) (*natPortForward, error) {
+			return createNATPortForward(ctx, client, *request, shouldApply(client, applySubsystemFilter))
+		},
+		getId: func(_ context.Context, _ *apiClient, response *natPortForward) (string, error) {
+			return response.Id, nil
+		},
+		properties: map[string]*resourceProperty[natPortForwardRequest, natPortForward]{
+			"description": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Description for the port forward.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natPortForwardRequest) error {
+					req.Descr = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natPortForward) (interface{}, error) {
+					return res.Descr, nil
+				},
+			},
+			"destination": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Destination address of the port forward. This may be a single IP, network CIDR, alias name, or interface. When specifying an interface, you may use the real interface ID (e.g. igb0), the descriptive interface name, or the pfSense ID (e.g. wan, lan, optx). To use only the interface's assigned address, add `ip` to the end of the interface name. To negate the context of the destination address, you may prefix the value with `!`.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natPortForwardRequest) error {
+					req.Dst = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natPortForward) (interface{}, error) {
+					if res.Destination == nil {
+						return nil, nil
+					}
+
+					return res.Destination.TargetString(), nil
+				},
+			},
+			"destination_port": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "TCP and/or UDP destination port, port range or port alias to match. This parameter is required when `protocol` is set to `tcp`, `udp`, or `tcp/udp`.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natPortForwardRequest) error {
+					req.DstPort = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natPortForward) (interface{}, error) {
+					if res.Destination == nil {
+						return nil, nil
+					}
+
+					return res.Destination.Port, nil
+				},
+			},
+			"disabled": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Disable the port forward.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natPortForwardRequest) error {
+					req.Disabled = d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *natPortForward) (interface{}, error) {
+					return bool(res.Disabled), nil
+				},
+			},
+			"filter_rule_association": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "add-associated",
+					Description:  "Firewall rule to create for the port forward. `add-associated` creates a firewall rule that is kept in sync with the port forward, `pass` passes matching traffic without a firewall rule and `none` leaves the traffic to be matched by other firewall rules.",
+					ValidateFunc: validation.StringInSlice([]string{"add-associated", "pass", "none"}, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natPortForwardRequest) error {
+					if association := d.Get(name).(string); association != "none" {
+						req.FilterRuleAssociation = association
+					}
+
+					return nil
+				},
+				getFromResponse: func(res *natPortForward) (interface{}, error) {
+					switch {
+					case res.AssociatedRuleId == "pass":
+						return "pass", nil
+					case strings.HasPrefix(res.AssociatedRuleId, "nat_"):
+						return "add-associated", nil
+					default:
+						return "none", nil
+					}
+				},
+			},
+			"interface": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Interface this port forward will apply to. You may specify either the interface's descriptive name, the pfSense interface ID (e.g. wan, lan, optx), or the real interface ID (e.g. igb0).",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natPortForwardRequest) error {
+					req.Interface = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natPortForward) (interface{}, error) {
+					return res.Interface, nil
+				},
+			},
+			"local_port": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Port on the target host to forward traffic to. For a port range, specify the first port of the range and the rest will be calculated automatically. This parameter is required when `protocol` is set to `tcp`, `udp`, or `tcp/udp`.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natPortForwardRequest) error {
+					req.LocalPort = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natPortForward) (interface{}, error) {
+					return res.LocalPort, nil
+				},
+			},
+			"nat_reflection": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "NAT reflection mode for this port forward. If not specified, the system default is used.",
+					ValidateFunc: validation.StringInSlice([]string{"enable", "disable", "purenat"}, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natPortForwardRequest) error {
+					req.NATReflection = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natPortForward) (interface{}, error) {
+					return res.NATReflection, nil
+				},
+			},
+			"protocol": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Transfer protocol this port forward will apply to.",
+					ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "tcp/udp", "icmp", "esp", "ah", "gre", "ipv6", "igmp", "pim", "ospf"}, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natPortForwardRequest) error {
+					req.Protocol = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natPortForward) (interface{}, error) {
+					return res.Protocol, nil
+				},
+			},
+			"source": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "any",
+					Description: "Source address of the port forward. This may be a single IP, network CIDR, alias name, or interface. When specifying an interface, you may use the real interface ID (e.g. igb0), the descriptive interface name, or the pfSense ID (e.g. wan, lan, optx). To use only the interface's assigned address, add `ip` to the end of the interface name. To negate the context of the source address, you may prefix the value with `!`.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natPortForwardRequest) error {
+					req.Src = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natPortForward) (interface{}, error) {
+					if res.Source == nil {
+						return nil, nil
+					}
+
+					return res.Source.TargetString(), nil
+				},
+			},
+			"source_port": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "any",
+					Description: "TCP and/or UDP source port, port range or port alias to match. You may specify `any` to match any source port.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natPortForwardRequest) error {
+					req.SrcPort = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natPortForward) (interface{}, error) {
+					if res.Source == nil {
+						return nil, nil
+					}
+
+					return res.Source.Port, nil
+				},
+			},
+			"target": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "IP address or alias of the internal host to forward traffic to.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *natPortForwardRequest) error {
+					req.Target = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *natPortForward) (interface{}, error) {
+					return res.Target, nil
+				},
+			},
+		},
+	}
+}
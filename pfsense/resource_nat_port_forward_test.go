@@ -0,0 +1,7 @@
+package pfsense
+
+func resourceNATPortForwardTest() resourceTest {
+	return &tfResourceTest[natPortForwardRequest, natPortForward, string]{
+		resource: resourceNATPortForward(),
+	}
+}
@@ -0,0 +1,246 @@
+package pfsense
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRoutingGateway() *resource[routingGatewayRequest, routingGateway, string] {
+	return &resource[routingGatewayRequest, routingGateway, string]{
+		name:        "pfsense_routing_gateway",
+		description: "Routing Gateway",
+		indexed:     true,
+		delete: func(ctx context.Context, client *apiClient, _ string, name string) error {
+			return deleteRoutingGateway(ctx, client, name, shouldApply(client, applySubsystemRouting))
+		},
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*routingGateway, error) {
+			return listRoutingGateways(ctx, client)
+		},
+		update: func(ctx context.Context, client *apiClient, name string, request *routingGatewayRequest) (*routingGateway, error) {
+			return updateRoutingGateway(ctx, client, name, *request, shouldApply(client, applySubsystemRouting))
+		},
+		create: func(ctx context.Context, client *apiClient, request *routingGatewayRequest) (*routingGateway, error) {
+			return createRoutingGateway(ctx, client, *request, shouldApply(client, applySubsystemRouting))
+		},
+		properties: map[string]*resourceProperty[routingGatewayRequest, routingGateway]{
+			"default_gateway": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Make this the default gateway for its `ip_protocol`.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.DefaultGateway = d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return res.IsDefaultGW, nil
+				},
+			},
+			"description": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Description for the gateway.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.Descr = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return res.Descr, nil
+				},
+			},
+			"disabled": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Disable the gateway.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.Disabled = d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return bool(res.Disabled), nil
+				},
+			},
+			"gateway": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "IP address of the gateway. This must be an address of the same IP type as `ip_protocol` within the subnet of `interface`.",
+					ValidateFunc: validation.IsIPAddress,
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.Gateway = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return res.Gateway, nil
+				},
+			},
+			"interface": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Interface the gateway is reachable through. You may specify either the interface's descriptive name, the pfSense interface ID (e.g. wan, lan, optx), or the real interface ID (e.g. igb0).",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.Interface = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return res.FriendlyIface, nil
+				},
+			},
+			"ip_protocol": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "inet",
+					Description:  "Address family of the gateway.",
+					ValidateFunc: validation.StringInSlice([]string{"inet", "inet6"}, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.IPProtocol = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return res.IPProtocol, nil
+				},
+			},
+			"latency_high": {
+				schema: &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      500,
+					Description:  "Latency in milliseconds above which the gateway is considered down. This must be greater than `latency_low`.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.LatencyHigh = d.Get(name).(int)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return res.LatencyHigh, nil
+				},
+			},
+			"latency_low": {
+				schema: &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      200,
+					Description:  "Latency in milliseconds above which the gateway is considered degraded.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.LatencyLow = d.Get(name).(int)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return res.LatencyLow, nil
+				},
+			},
+			"loss_high": {
+				schema: &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      20,
+					Description:  "Packet loss percentage above which the gateway is considered down. This must be greater than `loss_low`.",
+					ValidateFunc: validation.IntBetween(1, 100),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.LossHigh = d.Get(name).(int)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return res.LossHigh, nil
+				},
+			},
+			"loss_low": {
+				schema: &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      10,
+					Description:  "Packet loss percentage above which the gateway is considered degraded.",
+					ValidateFunc: validation.IntBetween(1, 100),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.LossLow = d.Get(name).(int)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return res.LossLow, nil
+				},
+			},
+			"monitor": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "IP address to ping to determine the gateway's health. If not specified, `gateway` is monitored.",
+					ValidateFunc: validation.IsIPAddress,
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.Monitor = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return res.Monitor, nil
+				},
+			},
+			"monitor_disable": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Disable gateway monitoring, the gateway will always be considered up.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.MonitorDisable = d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return bool(res.MonitorDisable), nil
+				},
+			},
+			"name": {
+				idProperty: true,
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "Name of the gateway. Only alpha-numeric and underscore characters are allowed.",
+					ValidateFunc: validation.StringMatch(regexValidator(`^\w+$`), "Only alpha-numeric and underscore characters are allowed"),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.Name = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return res.Name, nil
+				},
+			},
+			"weight": {
+				schema: &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					Description:  "Weight of the gateway when load balancing within a gateway group tier.",
+					ValidateFunc: validation.IntBetween(1, 30),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayRequest) error {
+					req.Weight = d.Get(name).(int)
+					return nil
+				},
+				getFromResponse: func(res *routingGateway) (interface{}, error) {
+					return res.Weight, nil
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,140 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRoutingGatewayGroup() *resource[routingGatewayGroupRequest, routingGatewayGroup, string] {
+	return &resource[routingGatewayGroupRequest, routingGatewayGroup, string]{
+		name:        "pfsense_routing_gateway_group",
+		description: "Routing Gateway Group",
+		indexed:     true,
+		delete: func(ctx context.Context, client *apiClient, _ string, name string) error {
+			return deleteRoutingGatewayGroup(ctx, client, name, shouldApply(client, applySubsystemRouting))
+		},
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*routingGatewayGroup, error) {
+			return listRoutingGatewayGroups(ctx, client)
+		},
+		update: func(ctx context.Context, client *apiClient, name string, request *routingGatewayGroupRequest) (*routingGatewayGroup, error) {
+			return updateRoutingGatewayGroup(ctx, client, name, *request, shouldApply(client, applySubsystemRouting))
+		},
+		create: func(ctx context.Context, client *apiClient, request *routingGatewayGroupRequest) (*routingGatewayGroup, error) {
+			return createRoutingGatewayGroup(ctx, client, *request, shouldApply(client, applySubsystemRouting))
+		},
+		properties: map[string]*resourceProperty[routingGatewayGroupRequest, routingGatewayGroup]{
+			"description": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Description for the gateway group.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayGroupRequest) error {
+					req.Descr = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *routingGatewayGroup) (interface{}, error) {
+					return res.Descr, nil
+				},
+			},
+			"member": {
+				schema: &schema.Schema{
+					Type:        schema.TypeList,
+					Required:    true,
+					Description: "Gateways in the group. Gateways in lower tiers are used first, gateways sharing a tier are load balanced.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"gateway": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Name of the gateway.",
+							},
+							"tier": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								Description:  "Tier of the gateway within the group.",
+								ValidateFunc: validation.IntBetween(1, 5),
+							},
+							"virtual_ip": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Virtual IP to use for this gateway, if not specified the interface address is used.",
+							},
+						},
+					},
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayGroupRequest) error {
+					members := d.Get(name).([]interface{})
+					req.Item = make([]string, len(members))
+
+					for i, member := range members {
+						memberMap := member.(map[string]interface{})
+						req.Item[i] = strings.Join([]string{
+							memberMap["gateway"].(string),
+							fmt.Sprint(memberMap["tier"]),
+							memberMap["virtual_ip"].(string),
+						}, gatewayGroupItemSeparator)
+					}
+
+					return nil
+				},
+				getFromResponse: func(res *routingGatewayGroup) (interface{}, error) {
+					members, err := res.members()
+
+					if err != nil {
+						return nil, err
+					}
+
+					result := make([]interface{}, len(members))
+
+					for i, member := range members {
+						result[i] = map[string]interface{}{
+							"gateway":    member.Gateway,
+							"tier":       member.Tier,
+							"virtual_ip": member.VirtualIP,
+						}
+					}
+
+					return result, nil
+				},
+			},
+			"name": {
+				idProperty: true,
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "Name of the gateway group. Only alpha-numeric and underscore characters are allowed.",
+					ValidateFunc: validation.StringMatch(regexValidator(`^\w+$`), "Only alpha-numeric and underscore characters are allowed"),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayGroupRequest) error {
+					req.Name = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *routingGatewayGroup) (interface{}, error) {
+					return res.Name, nil
+				},
+			},
+			"trigger_level": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "down",
+					Description:  "When to stop using a gateway in the group. `down` triggers on member down, `downloss` on packet loss, `downlatency` on high latency and `downlosslatency` on packet loss or high latency.",
+					ValidateFunc: validation.StringInSlice([]string{"down", "downloss", "downlatency", "downlosslatency"}, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingGatewayGroupRequest) error {
+					req.Trigger = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *routingGatewayGroup) (interface{}, error) {
+					return res.Trigger, nil
+				},
+			},
+		},
+	}
+}
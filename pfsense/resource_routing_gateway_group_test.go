@@ -0,0 +1,7 @@
+package pfsense
+
+func resourceRoutingGatewayGroupTest() resourceTest {
+	return &tfResourceTest[routingGatewayGroupRequest, routingGatewayGroup, string]{
+		resource: resourceRoutingGatewayGroup(),
+	}
+}
@@ -0,0 +1,7 @@
+package pfsense
+
+func resourceRoutingGatewayTest() resourceTest {
+	return &tfResourceTest[routingGatewayRequest, routingGateway, string]{
+		resource: resourceRoutingGateway(),
+	}
+}
@@ -0,0 +1,91 @@
+package pfsense
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRoutingStaticRoute() *resource[routingStaticRouteRequest, routingStaticRoute, string] {
+	return &resource[routingStaticRouteRequest, routingStaticRoute, string]{
+		name:        "pfsense_routing_static_route",
+		description: "Static Route",
+		indexed:     true,
+		importKeys:  []string{"description"},
+		delete: func(ctx context.Context, client *apiClient, _ string, network string) error {
+			return deleteRoutingStaticRoute(ctx, client, network, shouldApply(client, applySubsystemRouting))
+		},
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*routingStaticRoute, error) {
+			return listRoutingStaticRoutes(ctx, client)
+		},
+		update: func(ctx context.Context, client *apiClient, network string, request *routingStaticRouteRequest) (*routingStaticRoute, error) {
+			return updateRoutingStaticRoute(ctx, client, network, *request, shouldApply(client, applySubsystemRouting))
+		},
+		create: func(ctx context.Context, client *apiClient, request *routingStaticRouteRequest) (*routingStaticRoute, error) {
+			return createRoutingStaticRoute(ctx, client, *request, shouldApply(client, applySubsystemRouting))
+		},
+		properties: map[string]*resourceProperty[routingStaticRouteRequest, routingStaticRoute]{
+			"description": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Description for the static route.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingStaticRouteRequest) error {
+					req.Descr = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *routingStaticRoute) (interface{}, error) {
+					return res.Descr, nil
+				},
+			},
+			"disabled": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Disable the static route.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingStaticRouteRequest) error {
+					req.Disabled = d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *routingStaticRoute) (interface{}, error) {
+					return bool(res.Disabled), nil
+				},
+			},
+			"gateway": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of an existing gateway traffic to `network` will route over.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingStaticRouteRequest) error {
+					req.Gateway = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *routingStaticRoute) (interface{}, error) {
+					return res.Gateway, nil
+				},
+			},
+			"network": {
+				idProperty: true,
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "Destination network CIDR of the static route.",
+					ValidateFunc: validation.IsCIDR,
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *routingStaticRouteRequest) error {
+					req.Network = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *routingStaticRoute) (interface{}, error) {
+					return res.Network, nil
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,7 @@
+package pfsense
+
+func resourceRoutingStaticRouteTest() resourceTest {
+	return &tfResourceTest[routingStaticRouteRequest, routingStaticRoute, string]{
+		resource: resourceRoutingStaticRoute(),
+	}
+}
@@ -3,11 +3,12 @@ package pfsense
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	fuzz "github.com/AdaLogics/go-fuzz-headers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
 )
 
 type resourceTest interface {
@@ -48,6 +49,7 @@ func (r *tfResourceTest[RequestType, ResponseType, IdType]) RunTests(t *testing.
 		"functionsAreSet":        r.functionsAreSet,
 		"idTypeMatchesId":        r.idTypeMatchesId,
 		"partitionTypeIsString":  r.partitionTypeIsString,
+		"importKeysExist":        r.importKeysExist,
 	}
 
 	for name, testFunc := range testFuncs {
@@ -63,6 +65,21 @@ func (r *tfResourceTest[RequestType, ResponseType, IdType]) RunTests(t *testing.
 	if r.resource.delete != nil {
 		r.resource.delete = r.delete
 	}
+
+	if r.resource.get != nil {
+		r.resource.get = r.get
+	}
+
+	fakeTestFuncs := map[string]resourceTestFunc[RequestType, ResponseType, IdType]{
+		"readRemovesMissing":   r.readRemovesMissing,
+		"readKeepsFailedItems": r.readKeepsFailedItems,
+	}
+
+	for name, testFunc := range fakeTestFuncs {
+		t.Run(fmt.Sprintf("%s::%s", r.resource.name, name), func(t *testing.T) {
+			testFunc(t)
+		})
+	}
 }
 
 func (r *tfResourceTest[RequestType, ResponseType, IdType]) noMoreThanOneId(t *testing.T) {
@@ -144,6 +161,16 @@ func (r *tfResourceTest[RequestType, ResponseType, IdType]) partitionIsForceNew(
 	}
 }
 
+func (r *tfResourceTest[RequestType, ResponseType, IdType]) importKeysExist(t *testing.T) {
+	for _, key := range r.resource.importKeys {
+		property, ok := r.resource.properties[key]
+
+		if !ok || property.getFromResponse == nil {
+			t.Errorf("Import key %s on resource %s isn't a property read from responses", key, r.resource.name)
+		}
+	}
+}
+
 func (r *tfResourceTest[RequestType, ResponseType, IdType]) getIdIsSet(t *testing.T) {
 	if r.resource.getId == nil {
 		t.Errorf("Get ID function is not set on resource %s", r.resource.name)
@@ -170,7 +197,78 @@ func (r *tfResourceTest[RequestType, ResponseType, IdType]) functionsAreSet(t *t
 	}
 }
 
-func (r *tfResourceTest[RequestType, ResponseType, IdType]) create(_ context.Context, _ *pfsenseapi.Client, request *RequestType) (*ResponseType, error) {
+// readRemovesMissing reads an item that isn't in the current state, which should remove it from the Terraform state
+// with a warning rather than fail.
+func (r *tfResourceTest[RequestType, ResponseType, IdType]) readRemovesMissing(t *testing.T) {
+	resource := r.provider.ResourcesMap[r.resource.name]
+	d := resource.TestResourceData()
+	d.SetId(r.testMissingId())
+
+	diags := resource.ReadContext(context.Background(), d, &apiClient{})
+
+	if diags.HasError() {
+		t.Errorf("Expected a missing item to be removed from the state but found %v on %s", diags, r.resource.name)
+	} else if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("Expected a warning when removing a missing item but found %v on %s", diags, r.resource.name)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("Expected the ID to be cleared but found %s on %s", d.Id(), r.resource.name)
+	}
+}
+
+// readKeepsFailedItems reads an item when the API responds with 404, which doesn't say whether the item exists. The
+// read should fail and leave the item in the Terraform state.
+func (r *tfResourceTest[RequestType, ResponseType, IdType]) readKeepsFailedItems(t *testing.T) {
+	list, get := r.resource.list, r.resource.get
+	defer func() { r.resource.list, r.resource.get = list, get }()
+
+	notFound := &apiStatusError{statusCode: http.StatusNotFound, message: "Object not found"}
+
+	r.resource.list = func(_ context.Context, _ *apiClient, _ string) ([]*ResponseType, error) {
+		return nil, notFound
+	}
+
+	if get != nil {
+		r.resource.get = func(_ context.Context, _ *apiClient, _ string, _ IdType) (*ResponseType, error) {
+			return nil, notFound
+		}
+	}
+
+	resource := r.provider.ResourcesMap[r.resource.name]
+	d := resource.TestResourceData()
+	id := r.testMissingId()
+	d.SetId(id)
+
+	if diags := resource.ReadContext(context.Background(), d, &apiClient{}); !diags.HasError() {
+		t.Errorf("Expected the read to fail but found %v on %s", diags, r.resource.name)
+	}
+
+	if d.Id() != id {
+		t.Errorf("Expected the ID to be kept but found %q on %s", d.Id(), r.resource.name)
+	}
+}
+
+func (r *tfResourceTest[RequestType, ResponseType, IdType]) testMissingId() string {
+	var id IdType
+
+	switch value := any(&id).(type) {
+	case *int:
+		*value = 1
+	case *string:
+		*value = "missing"
+	}
+
+	var partition string
+
+	if r.resource.partitionId != "" {
+		partition = "lan"
+	}
+
+	return r.resource.formatId(partition, id)
+}
+
+func (r *tfResourceTest[RequestType, ResponseType, IdType]) create(_ context.Context, _ *apiClient, request *RequestType) (*ResponseType, error) {
 	result, err := r.convert(request)
 
 	if err != nil {
@@ -196,7 +294,7 @@ func (r *tfResourceTest[RequestType, ResponseType, IdType]) initPartition(partit
 	}
 }
 
-func (r *tfResourceTest[RequestType, ResponseType, IdType]) update(ctx context.Context, client *pfsenseapi.Client, id IdType, request *RequestType) (*ResponseType, error) {
+func (r *tfResourceTest[RequestType, ResponseType, IdType]) update(ctx context.Context, client *apiClient, id IdType, request *RequestType) (*ResponseType, error) {
 	result, err := r.convert(request)
 
 	if err != nil {
@@ -230,7 +328,7 @@ func (r *tfResourceTest[RequestType, ResponseType, IdType]) update(ctx context.C
 	return nil, fmt.Errorf("Test error, unable to find Id %v within partition %s on resource %s", id, partition, r.resource.name)
 }
 
-func (r *tfResourceTest[RequestType, ResponseType, IdType]) delete(ctx context.Context, client *pfsenseapi.Client, partition string, id IdType) error {
+func (r *tfResourceTest[RequestType, ResponseType, IdType]) delete(ctx context.Context, client *apiClient, partition string, id IdType) error {
 	r.initPartition(partition)
 
 	for i, item := range r.currentState[partition] {
@@ -249,7 +347,25 @@ func (r *tfResourceTest[RequestType, ResponseType, IdType]) delete(ctx context.C
 	return fmt.Errorf("Test error, unable to find Id %v within partition %s on resource %s", id, partition, r.resource.name)
 }
 
-func (r *tfResourceTest[RequestType, ResponseType, IdType]) list(_ context.Context, _ *pfsenseapi.Client, partition string) ([]*ResponseType, error) {
+func (r *tfResourceTest[RequestType, ResponseType, IdType]) get(ctx context.Context, client *apiClient, partition string, id IdType) (*ResponseType, error) {
+	r.initPartition(partition)
+
+	for _, item := range r.currentState[partition] {
+		itemId, err := r.resource.getId(ctx, client, item)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if id == itemId {
+			return item, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *tfResourceTest[RequestType, ResponseType, IdType]) list(_ context.Context, _ *apiClient, partition string) ([]*ResponseType, error) {
 	r.initPartition(partition)
 	return r.currentState[partition], nil
 }
@@ -0,0 +1,329 @@
+package pfsense
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+const trafficShaperEnabled = "on"
+
+var trafficShaperMaskValues = []string{"none", "srcaddress", "dstaddress"}
+var trafficShaperAQMValues = []string{"droptail", "codel", "pie", "red", "gred"}
+
+func resourceTrafficShaperLimiter() *resource[trafficShaperLimiterRequest, trafficShaperLimiter, string] {
+	return &resource[trafficShaperLimiterRequest, trafficShaperLimiter, string]{
+		name:        "pfsense_traffic_shaper_limiter",
+		description: "Traffic Shaper Limiter",
+		indexed:     true,
+		delete: func(ctx context.Context, client *apiClient, _ string, name string) error {
+			return deleteTrafficShaperLimiter(ctx, client, name, shouldApply(client, applySubsystemFilter))
+		},
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*trafficShaperLimiter, error) {
+			return listTrafficShaperLimiters(ctx, client)
+		},
+		update: func(ctx context.Context, client *apiClient, name string, request *trafficShaperLimiterRequest) (*trafficShaperLimiter, error) {
+			return updateTrafficShaperLimiter(ctx, client, name, *request, shouldApply(client, applySubsystemFilter))
+		},
+		create: func(ctx context.Context, client *apiClient, request *trafficShaperLimiterRequest) (*trafficShaperLimiter, error) {
+			return createTrafficShaperLimiter(ctx, client, *request, shouldApply(client, applySubsystemFilter))
+		},
+		properties: map[string]*resourceProperty[trafficShaperLimiterRequest, trafficShaperLimiter]{
+			"aqm": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "droptail",
+					Description:  "Active queue management algorithm of the limiter.",
+					ValidateFunc: validation.StringInSlice(trafficShaperAQMValues, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperLimiterRequest) error {
+					req.AQM = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperLimiter) (interface{}, error) {
+					return res.AQM, nil
+				},
+			},
+			"bandwidth": {
+				schema: &schema.Schema{
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  "Bandwidth of the limiter in `bandwidth_scale` units.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperLimiterRequest) error {
+					if len(req.Bandwidth) == 0 {
+						req.Bandwidth = []*trafficShaperLimiterBandwidthRequest{{}}
+					}
+
+					req.Bandwidth[0].Bandwidth = d.Get(name).(int)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperLimiter) (interface{}, error) {
+					if res.Bandwidth == nil || len(res.Bandwidth.Item) == 0 {
+						return nil, nil
+					}
+
+					return res.Bandwidth.Item[0].Bandwidth, nil
+				},
+			},
+			"bandwidth_scale": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "Mb",
+					Description:  "Units of `bandwidth`.",
+					ValidateFunc: validation.StringInSlice([]string{"b", "Kb", "Mb"}, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperLimiterRequest) error {
+					if len(req.Bandwidth) == 0 {
+						req.Bandwidth = []*trafficShaperLimiterBandwidthRequest{{}}
+					}
+
+					req.Bandwidth[0].BandwidthScale = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperLimiter) (interface{}, error) {
+					if res.Bandwidth == nil || len(res.Bandwidth.Item) == 0 {
+						return nil, nil
+					}
+
+					return res.Bandwidth.Item[0].BandwidthScale, nil
+				},
+			},
+			"delay": {
+				schema: &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Delay in milliseconds added to traffic passing through the limiter.",
+					ValidateFunc: validation.IntBetween(0, 10000),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperLimiterRequest) error {
+					req.Delay = d.Get(name).(int)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperLimiter) (interface{}, error) {
+					return res.Delay, nil
+				},
+			},
+			"description": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Description for the limiter.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperLimiterRequest) error {
+					req.Description = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperLimiter) (interface{}, error) {
+					return res.Description, nil
+				},
+			},
+			"disabled": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Disable the limiter.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperLimiterRequest) error {
+					req.Enabled = !d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperLimiter) (interface{}, error) {
+					return res.Enabled != trafficShaperEnabled, nil
+				},
+			},
+			"mask": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "none",
+					Description:  "Create a dynamic limiter per source or destination address instead of sharing the limiter between all traffic.",
+					ValidateFunc: validation.StringInSlice(trafficShaperMaskValues, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperLimiterRequest) error {
+					req.Mask = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperLimiter) (interface{}, error) {
+					return res.Mask, nil
+				},
+			},
+			"mask_bits": {
+				schema: &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "IPv4 prefix length used to group addresses when `mask` is set.",
+					ValidateFunc: validation.IntBetween(1, 32),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperLimiterRequest) error {
+					req.MaskBits = d.Get(name).(int)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperLimiter) (interface{}, error) {
+					return res.MaskBits, nil
+				},
+			},
+			"mask_bits_v6": {
+				schema: &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "IPv6 prefix length used to group addresses when `mask` is set.",
+					ValidateFunc: validation.IntBetween(1, 128),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperLimiterRequest) error {
+					req.MaskBitsV6 = d.Get(name).(int)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperLimiter) (interface{}, error) {
+					return res.MaskBitsV6, nil
+				},
+			},
+			"name": {
+				idProperty: true,
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "Name of the limiter, this is the value `pfsense_firewall_rule.dn_pipe` and `pfsense_firewall_rule.pdn_pipe` refer to. Only alpha-numeric and underscore characters are allowed.",
+					ValidateFunc: validation.StringMatch(regexValidator(`^\w+$`), "Only alpha-numeric and underscore characters are allowed"),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperLimiterRequest) error {
+					req.Name = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperLimiter) (interface{}, error) {
+					return res.Name, nil
+				},
+			},
+			"queue": {
+				schema: &schema.Schema{
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Child queues of the limiter, these share the bandwidth of the limiter according to their weight.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"aqm": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "droptail",
+								Description:  "Active queue management algorithm of the queue.",
+								ValidateFunc: validation.StringInSlice(trafficShaperAQMValues, false),
+							},
+							"description": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Description for the queue.",
+							},
+							"disabled": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Disable the queue.",
+							},
+							"mask": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "none",
+								Description:  "Create a dynamic queue per source or destination address.",
+								ValidateFunc: validation.StringInSlice(trafficShaperMaskValues, false),
+							},
+							"mask_bits": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Description:  "IPv4 prefix length used to group addresses when `mask` is set.",
+								ValidateFunc: validation.IntBetween(1, 32),
+							},
+							"mask_bits_v6": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Description:  "IPv6 prefix length used to group addresses when `mask` is set.",
+								ValidateFunc: validation.IntBetween(1, 128),
+							},
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Name of the queue. Only alpha-numeric and underscore characters are allowed.",
+								ValidateFunc: validation.StringMatch(regexValidator(`^\w+$`), "Only alpha-numeric and underscore characters are allowed"),
+							},
+							"weight": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Description:  "Share of the limiter's bandwidth given to this queue relative to its siblings.",
+								ValidateFunc: validation.IntBetween(1, 100),
+							},
+						},
+					},
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperLimiterRequest) error {
+					queues := d.Get(name).([]interface{})
+					req.Queue = make([]*trafficShaperLimiterQueueRequest, len(queues))
+
+					for i, queue := range queues {
+						queueMap := queue.(map[string]interface{})
+						req.Queue[i] = &trafficShaperLimiterQueueRequest{
+							AQM:         queueMap["aqm"].(string),
+							Description: queueMap["description"].(string),
+							Enabled:     !queueMap["disabled"].(bool),
+							Mask:        queueMap["mask"].(string),
+							MaskBits:    queueMap["mask_bits"].(int),
+							MaskBitsV6:  queueMap["mask_bits_v6"].(int),
+							Name:        queueMap["name"].(string),
+							Weight:      queueMap["weight"].(int),
+						}
+					}
+
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperLimiter) (interface{}, error) {
+					queues := make([]interface{}, len(res.Queue))
+
+					for i, queue := range res.Queue {
+						queueMap := map[string]interface{}{
+							"aqm":         queue.AQM,
+							"description": queue.Description,
+							"disabled":    queue.Enabled != trafficShaperEnabled,
+							"mask":        queue.Mask,
+							"name":        queue.Name,
+						}
+
+						for key, value := range map[string]pfsenseapi.OptionalJSONInt{
+							"mask_bits":    queue.MaskBits,
+							"mask_bits_v6": queue.MaskBitsV6,
+							"weight":       queue.Weight,
+						} {
+							if value.Value != nil {
+								queueMap[key] = *value.Value
+							}
+						}
+
+						queues[i] = queueMap
+					}
+
+					return queues, nil
+				},
+			},
+			"scheduler": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "wf2q+",
+					Description:  "Scheduler used to share bandwidth between the limiter's queues.",
+					ValidateFunc: validation.StringInSlice([]string{"wf2q+", "fifo", "qfq", "rr", "prio", "fq_codel", "fq_pie"}, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperLimiterRequest) error {
+					req.Scheduler = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperLimiter) (interface{}, error) {
+					return res.Scheduler, nil
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package pfsense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+func resourceTrafficShaperLimiterTest() resourceTest {
+	return &tfResourceTest[trafficShaperLimiterRequest, trafficShaperLimiter, string]{
+		resource: resourceTrafficShaperLimiter(),
+	}
+}
+
+func Test_TrafficShaperLimiterReadRemovesMissing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": "ok", "code": 200, "return": 0, "message": "Success", "data": [{"name": "upload", "enabled": "on"}]}`)
+	}))
+
+	defer server.Close()
+
+	client := testAPIClient(t, pfsenseapi.Config{Host: server.URL, LocalAuthEnabled: true}, apiClientSettings{})
+
+	if _, err := updateTrafficShaperLimiter(context.Background(), client, "missing", trafficShaperLimiterRequest{Name: "missing"}, false); !errors.Is(err, errNotFound) {
+		t.Errorf("Expected the limiter not to be found but got %v", err)
+	}
+
+	provider := Provider()
+	resource := provider.ResourcesMap["pfsense_traffic_shaper_limiter"]
+	d := resource.TestResourceData()
+	d.SetId("missing")
+
+	diags := resource.ReadContext(context.Background(), d, client)
+
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("Expected a warning when removing the missing limiter but found %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("Expected the ID to be cleared but found %s", d.Id())
+	}
+}
@@ -0,0 +1,195 @@
+package pfsense
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTrafficShaperQueue() *resource[trafficShaperQueueRequest, trafficShaperQueue, string] {
+	return &resource[trafficShaperQueueRequest, trafficShaperQueue, string]{
+		name:        "pfsense_traffic_shaper_queue",
+		description: "Traffic Shaper Queue on an interface's ALTQ shaper",
+		delete: func(ctx context.Context, client *apiClient, iface string, name string) error {
+			return deleteTrafficShaperQueue(ctx, client, iface, name, shouldApply(client, applySubsystemFilter))
+		},
+		list: func(ctx context.Context, client *apiClient, iface string) ([]*trafficShaperQueue, error) {
+			return listTrafficShaperQueues(ctx, client, iface)
+		},
+		update: func(ctx context.Context, client *apiClient, _ string, request *trafficShaperQueueRequest) (*trafficShaperQueue, error) {
+			return updateTrafficShaperQueue(ctx, client, *request, shouldApply(client, applySubsystemFilter))
+		},
+		create: func(ctx context.Context, client *apiClient, request *trafficShaperQueueRequest) (*trafficShaperQueue, error) {
+			return createTrafficShaperQueue(ctx, client, *request, shouldApply(client, applySubsystemFilter))
+		},
+		properties: map[string]*resourceProperty[trafficShaperQueueRequest, trafficShaperQueue]{
+			"bandwidth": {
+				schema: &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Bandwidth of the queue in `bandwidth_type` units.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperQueueRequest) error {
+					req.Bandwidth = d.Get(name).(int)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperQueue) (interface{}, error) {
+					return res.Bandwidth, nil
+				},
+			},
+			"bandwidth_type": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "Mb",
+					Description:  "Units of `bandwidth`, `%` makes the bandwidth a percentage of the parent's bandwidth.",
+					ValidateFunc: validation.StringInSlice([]string{"%", "b", "Kb", "Mb", "Gb"}, false),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperQueueRequest) error {
+					req.BandwidthType = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperQueue) (interface{}, error) {
+					return res.BandwidthType, nil
+				},
+			},
+			"codel": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Use CoDel active queue management on the queue.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperQueueRequest) error {
+					req.Codel = d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperQueue) (interface{}, error) {
+					return bool(res.Codel), nil
+				},
+			},
+			"default": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Make this the default queue of the interface, traffic not matched by a firewall rule is placed in it. Each interface needs exactly one default queue.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperQueueRequest) error {
+					req.Default = d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperQueue) (interface{}, error) {
+					return bool(res.Default), nil
+				},
+			},
+			"description": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Description for the queue.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperQueueRequest) error {
+					req.Description = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperQueue) (interface{}, error) {
+					return res.Description, nil
+				},
+			},
+			"disabled": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Disable the queue.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperQueueRequest) error {
+					req.Enabled = !d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperQueue) (interface{}, error) {
+					return res.Enabled != trafficShaperEnabled, nil
+				},
+			},
+			"ecn": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Use explicit congestion notification instead of dropping packets when the queue is congested.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperQueueRequest) error {
+					req.ECN = d.Get(name).(bool)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperQueue) (interface{}, error) {
+					return bool(res.ECN), nil
+				},
+			},
+			"interface": {
+				partition: true,
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "pfSense interface ID (e.g. wan, lan, optx) of the shaper this queue belongs to, the interface must already have a traffic shaper configured.",
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperQueueRequest) error {
+					req.Interface = d.Get(name).(string)
+					return nil
+				},
+			},
+			"name": {
+				idProperty: true,
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "Name of the queue, this is the value `pfsense_firewall_rule.default_queue` and `pfsense_firewall_rule.ack_queue` refer to. Only alpha-numeric and underscore characters are allowed.",
+					ValidateFunc: validation.StringMatch(regexValidator(`^\w+$`), "Only alpha-numeric and underscore characters are allowed"),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperQueueRequest) error {
+					req.Name = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperQueue) (interface{}, error) {
+					return res.Name, nil
+				},
+			},
+			"priority": {
+				schema: &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					Description:  "Priority of the queue, higher priority queues are preferred when the link is congested.",
+					ValidateFunc: validation.IntBetween(0, 15),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperQueueRequest) error {
+					req.Priority = d.Get(name).(int)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperQueue) (interface{}, error) {
+					return res.Priority, nil
+				},
+			},
+			"queue_limit": {
+				schema: &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Number of packets that can be held in the queue before they are dropped.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				updateRequest: func(d *schema.ResourceData, name string, req *trafficShaperQueueRequest) error {
+					req.QLimit = d.Get(name).(int)
+					return nil
+				},
+				getFromResponse: func(res *trafficShaperQueue) (interface{}, error) {
+					return res.QLimit, nil
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package pfsense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+func resourceTrafficShaperQueueTest() resourceTest {
+	return &tfResourceTest[trafficShaperQueueRequest, trafficShaperQueue, string]{
+		resource: resourceTrafficShaperQueue(),
+	}
+}
+
+func Test_TrafficShaperQueueReadRemovesMissing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": "ok", "code": 200, "return": 0, "message": "Success", "data": [{"interface": "lan", "name": "lan", "queue": [{"name": "voip"}]}]}`)
+	}))
+
+	defer server.Close()
+
+	client := testAPIClient(t, pfsenseapi.Config{Host: server.URL, LocalAuthEnabled: true}, apiClientSettings{})
+
+	if _, err := getTrafficShaperQueue(context.Background(), client, "lan", "missing"); !errors.Is(err, errNotFound) {
+		t.Errorf("Expected the queue not to be found but got %v", err)
+	}
+
+	provider := Provider()
+	resource := provider.ResourcesMap["pfsense_traffic_shaper_queue"]
+	d := resource.TestResourceData()
+	d.SetId("lan/missing")
+
+	diags := resource.ReadContext(context.Background(), d, client)
+
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("Expected a warning when removing the missing queue but found %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("Expected the ID to be cleared but found %s", d.Id())
+	}
+}
@@ -19,18 +19,18 @@ func resourceUnboundHostOverride() *resource[pfsenseapi.UnboundHostOverride, pfs
 	return &resource[pfsenseapi.UnboundHostOverride, pfsenseapi.UnboundHostOverride, string]{
 		name:        "pfsense_unbound_host_override",
 		description: "Unbound Host Override",
-		delete: func(ctx context.Context, client *pfsenseapi.Client, _ string, dns string) error {
+		delete: func(ctx context.Context, client *apiClient, _ string, dns string) error {
 			host_name, domain_name := splitDns(dns)
-			return client.Unbound.DeleteHostOverride(ctx, host_name, domain_name, true)
+			return client.Unbound.DeleteHostOverride(ctx, host_name, domain_name, shouldApply(client, applySubsystemUnbound))
 		},
-		list: func(ctx context.Context, client *pfsenseapi.Client, _ string) ([]*pfsenseapi.UnboundHostOverride, error) {
+		list: func(ctx context.Context, client *apiClient, _ string) ([]*pfsenseapi.UnboundHostOverride, error) {
 			return client.Unbound.ListHostOverrides(ctx)
 		},
-		update: func(ctx context.Context, client *pfsenseapi.Client, _ string, request *pfsenseapi.UnboundHostOverride) (*pfsenseapi.UnboundHostOverride, error) {
-			return client.Unbound.UpdateHostOverride(ctx, request, true)
+		update: func(ctx context.Context, client *apiClient, _ string, request *pfsenseapi.UnboundHostOverride) (*pfsenseapi.UnboundHostOverride, error) {
+			return client.Unbound.UpdateHostOverride(ctx, request, shouldApply(client, applySubsystemUnbound))
 		},
-		create: func(ctx context.Context, client *pfsenseapi.Client, request *pfsenseapi.UnboundHostOverride) (*pfsenseapi.UnboundHostOverride, error) {
-			return client.Unbound.CreateHostOverride(ctx, request, true)
+		create: func(ctx context.Context, client *apiClient, request *pfsenseapi.UnboundHostOverride) (*pfsenseapi.UnboundHostOverride, error) {
+			return client.Unbound.CreateHostOverride(ctx, request, shouldApply(client, applySubsystemUnbound))
 		},
 		properties: map[string]*resourceProperty[pfsenseapi.UnboundHostOverride, pfsenseapi.UnboundHostOverride]{
 			"dns": {
@@ -0,0 +1,91 @@
+package pfsense
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+)
+
+var defaultRetryOnStatus = []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+
+type retryPolicy struct {
+	maxAttempts   int
+	minBackoff    time.Duration
+	maxBackoff    time.Duration
+	retryOnStatus []int
+}
+
+func defaultRetryPolicy() retryPolicy {
+	return retryPolicy{
+		maxAttempts:   3,
+		minBackoff:    time.Second,
+		maxBackoff:    30 * time.Second,
+		retryOnStatus: defaultRetryOnStatus,
+	}
+}
+
+// backoff doubles the wait after every attempt, starting at minBackoff and never waiting longer than maxBackoff.
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	wait := p.minBackoff
+
+	for i := 1; i < attempt && wait < p.maxBackoff; i++ {
+		wait *= 2
+	}
+
+	return min(wait, p.maxBackoff)
+}
+
+// retryTransport retries requests that failed in a way that is safe to retry. Reads are retried on network errors
+// and on the configured status codes, changes are only retried when the connection couldn't be made as pfSense may
+// have already made a change that failed part way through, such as creating an item twice.
+type retryTransport struct {
+	base   http.RoundTripper
+	policy retryPolicy
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+
+			if err != nil {
+				return nil, err
+			}
+
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		res, err := t.base.RoundTrip(attemptReq)
+
+		if attempt >= t.policy.maxAttempts || !t.shouldRetry(idempotent, res, err) {
+			return res, err
+		}
+
+		if res != nil {
+			res.Body.Close()
+		}
+
+		select {
+		case <-time.After(t.policy.backoff(attempt)):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+func (t *retryTransport) shouldRetry(idempotent bool, res *http.Response, err error) bool {
+	if err != nil {
+		var opErr *net.OpError
+
+		return idempotent || (errors.As(err, &opErr) && opErr.Op == "dial")
+	}
+
+	return idempotent && slices.Contains(t.policy.retryOnStatus, res.StatusCode)
+}
@@ -0,0 +1,117 @@
+package pfsense
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func testRetryPolicy() retryPolicy {
+	return retryPolicy{maxAttempts: 3, minBackoff: time.Millisecond, maxBackoff: time.Millisecond, retryOnStatus: defaultRetryOnStatus}
+}
+
+func Test_RetryBackoff(t *testing.T) {
+	policy := retryPolicy{minBackoff: time.Second, maxBackoff: 5 * time.Second}
+
+	for attempt, expected := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second, 10: 5 * time.Second} {
+		if backoff := policy.backoff(attempt); backoff != expected {
+			t.Errorf("Expected a backoff of %v after attempt %d but found %v", expected, attempt, backoff)
+		}
+	}
+}
+
+func Test_RetryTransport(t *testing.T) {
+	dialError := &net.OpError{Op: "dial", Err: fmt.Errorf("connection refused")}
+	readError := &net.OpError{Op: "read", Err: fmt.Errorf("connection reset by peer")}
+
+	tests := map[string]struct {
+		method   string
+		status   int
+		err      error
+		attempts int32
+	}{
+		"readSucceeds":       {method: http.MethodGet, status: http.StatusOK, attempts: 1},
+		"readRetriesStatus":  {method: http.MethodGet, status: http.StatusServiceUnavailable, attempts: 3},
+		"readIgnoresStatus":  {method: http.MethodGet, status: http.StatusNotFound, attempts: 1},
+		"readRetriesError":   {method: http.MethodGet, err: readError, attempts: 3},
+		"createNoStatus":     {method: http.MethodPost, status: http.StatusServiceUnavailable, attempts: 1},
+		"createNoReadError":  {method: http.MethodPost, err: readError, attempts: 1},
+		"createRetriesDial":  {method: http.MethodPost, err: dialError, attempts: 3},
+		"deleteNoStatus":     {method: http.MethodDelete, status: http.StatusBadGateway, attempts: 1},
+		"updateRetriesDial":  {method: http.MethodPut, err: dialError, attempts: 3},
+		"updateNoReadError":  {method: http.MethodPut, err: readError, attempts: 1},
+		"updateIgnoresOkay":  {method: http.MethodPut, status: http.StatusOK, attempts: 1},
+		"deleteRetriesDial":  {method: http.MethodDelete, err: dialError, attempts: 3},
+		"createSucceedsOnce": {method: http.MethodPost, status: http.StatusOK, attempts: 1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var attempts int32
+
+			transport := &retryTransport{
+				policy: testRetryPolicy(),
+				base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					atomic.AddInt32(&attempts, 1)
+
+					if body, _ := io.ReadAll(req.Body); string(body) != "body" {
+						t.Errorf("Expected the body to be sent on every attempt but found %s", body)
+					}
+
+					if test.err != nil {
+						return nil, test.err
+					}
+
+					return &http.Response{StatusCode: test.status, Body: io.NopCloser(&bytes.Buffer{})}, nil
+				}),
+			}
+
+			req, _ := http.NewRequest(test.method, "https://pfsense.test", bytes.NewBufferString("body"))
+			transport.RoundTrip(req)
+
+			if attempts != test.attempts {
+				t.Errorf("Expected %d attempts but found %d", test.attempts, attempts)
+			}
+		})
+	}
+}
+
+func Test_LibraryRequestsAreRetried(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		fmt.Fprint(w, `{"status": "ok", "code": 200, "return": 0, "message": "Success", "data": []}`)
+	}))
+
+	defer server.Close()
+
+	client := testAPIClient(t, pfsenseapi.Config{Host: server.URL}, apiClientSettings{retry: testRetryPolicy()})
+
+	if _, err := client.Firewall.ListAliases(context.Background()); err != nil {
+		t.Errorf("Expected the list to succeed after retrying but found %v", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts but found %d", attempts)
+	}
+}
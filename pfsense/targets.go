@@ -0,0 +1,94 @@
+package pfsense
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+const (
+	defaultEndpointName = "primary"
+	targetAll           = "all"
+	targetProperty      = "target_endpoint"
+	targetIdsProperty   = "endpoint_ids"
+)
+
+// apiEndpoint is a pfSense the provider is configured to manage, e.g. a node of a CARP HA pair.
+type apiEndpoint struct {
+	name string
+	url  string
+}
+
+// newAPIClients creates a client for each endpoint, the first is the one data sources and imports use. Every client
+// knows about the others so that resources can be applied to more than one of them.
+func newAPIClients(config pfsenseapi.Config, endpoints []apiEndpoint, defaultTarget string, settings apiClientSettings) (*apiClient, error) {
+	clients := make([]*apiClient, len(endpoints))
+
+	for i, endpoint := range endpoints {
+		for _, other := range endpoints[:i] {
+			if other.name == endpoint.name {
+				return nil, fmt.Errorf("Endpoint %s is configured more than once", endpoint.name)
+			}
+		}
+
+		if endpoint.name == targetAll {
+			return nil, fmt.Errorf("Unable to name an endpoint %s, it's used to target every endpoint", targetAll)
+		}
+
+		endpointConfig := config
+		endpointConfig.Host = endpoint.url
+
+		client, err := newAPIClient(endpointConfig, settings)
+
+		if err != nil {
+			return nil, err
+		}
+
+		client.name = endpoint.name
+		clients[i] = client
+	}
+
+	if defaultTarget == "" {
+		defaultTarget = endpoints[0].name
+	}
+
+	for _, client := range clients {
+		client.endpoints = clients
+		client.defaultTarget = defaultTarget
+	}
+
+	if _, err := clients[0].targets(""); err != nil {
+		return nil, err
+	}
+
+	return clients[0], nil
+}
+
+// targets returns the clients of the endpoints a resource is applied to, the default target is used when the
+// resource doesn't set one.
+func (c *apiClient) targets(target string) ([]*apiClient, error) {
+	if len(c.endpoints) == 0 {
+		return []*apiClient{c}, nil
+	}
+
+	if target == "" {
+		target = c.defaultTarget
+	}
+
+	if target == targetAll {
+		return c.endpoints, nil
+	}
+
+	names := make([]string, len(c.endpoints))
+
+	for i, client := range c.endpoints {
+		if client.name == target {
+			return []*apiClient{client}, nil
+		}
+
+		names[i] = client.name
+	}
+
+	return nil, fmt.Errorf("Unable to find endpoint %s, expected %s or one of %s", target, targetAll, strings.Join(names, ", "))
+}
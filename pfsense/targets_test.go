@@ -0,0 +1,252 @@
+package pfsense
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+type testEndpointItem struct {
+	Name  string
+	Value string
+}
+
+// testEndpointResource is a resource kept in memory for each endpoint.
+func testEndpointResource(state map[string]map[string]*testEndpointItem) *schema.Resource {
+	save := func(_ context.Context, client *apiClient, request *testEndpointItem) (*testEndpointItem, error) {
+		if state[client.name] == nil {
+			state[client.name] = map[string]*testEndpointItem{}
+		}
+
+		item := *request
+		state[client.name][item.Name] = &item
+
+		return &item, nil
+	}
+
+	r := &resource[testEndpointItem, testEndpointItem, string]{
+		name:        "pfsense_test_endpoint_item",
+		description: "Test item",
+		create:      save,
+		update: func(ctx context.Context, client *apiClient, _ string, request *testEndpointItem) (*testEndpointItem, error) {
+			return save(ctx, client, request)
+		},
+		delete: func(_ context.Context, client *apiClient, _ string, id string) error {
+			delete(state[client.name], id)
+			return nil
+		},
+		list: func(_ context.Context, client *apiClient, _ string) ([]*testEndpointItem, error) {
+			list := []*testEndpointItem{}
+
+			for _, item := range state[client.name] {
+				list = append(list, item)
+			}
+
+			return list, nil
+		},
+		properties: map[string]*resourceProperty[testEndpointItem, testEndpointItem]{
+			"name": {
+				idProperty: true,
+				schema:     &schema.Schema{Type: schema.TypeString, Required: true, ForceNew: true, Description: "Name"},
+				updateRequest: func(d *schema.ResourceData, name string, req *testEndpointItem) error {
+					req.Name = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *testEndpointItem) (interface{}, error) {
+					return res.Name, nil
+				},
+			},
+			"value": {
+				schema: &schema.Schema{Type: schema.TypeString, Optional: true, Description: "Value"},
+				updateRequest: func(d *schema.ResourceData, name string, req *testEndpointItem) error {
+					req.Value = d.Get(name).(string)
+					return nil
+				},
+				getFromResponse: func(res *testEndpointItem) (interface{}, error) {
+					return res.Value, nil
+				},
+			},
+		},
+	}
+
+	provider := &schema.Provider{ResourcesMap: map[string]*schema.Resource{}}
+	r.AddResource(provider)
+
+	return provider.ResourcesMap[r.name]
+}
+
+func testEndpointClients(defaultTarget string) *apiClient {
+	primary := &apiClient{name: "primary", defaultTarget: defaultTarget}
+	secondary := &apiClient{name: "secondary", defaultTarget: defaultTarget}
+	primary.endpoints = []*apiClient{primary, secondary}
+	secondary.endpoints = primary.endpoints
+
+	return primary
+}
+
+func Test_ResourceTargets(t *testing.T) {
+	tests := map[string]struct {
+		defaultTarget string
+		target        string
+		expected      []string
+	}{
+		"default":          {defaultTarget: "primary", expected: []string{"primary"}},
+		"defaultAll":       {defaultTarget: targetAll, expected: []string{"primary", "secondary"}},
+		"targetSecondary":  {defaultTarget: "primary", target: "secondary", expected: []string{"secondary"}},
+		"targetAll":        {defaultTarget: "primary", target: targetAll, expected: []string{"primary", "secondary"}},
+		"targetOverridden": {defaultTarget: targetAll, target: "primary", expected: []string{"primary"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := map[string]map[string]*testEndpointItem{}
+			resource := testEndpointResource(state)
+			client := testEndpointClients(test.defaultTarget)
+			config := map[string]interface{}{"name": "web", "value": "80"}
+
+			if test.target != "" {
+				config[targetProperty] = test.target
+			}
+
+			d := resource.TestResourceData()
+
+			for key, value := range config {
+				d.Set(key, value)
+			}
+
+			if diags := resource.CreateContext(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("Unexpected error %v", diags)
+			}
+
+			for _, endpoint := range []string{"primary", "secondary"} {
+				_, created := state[endpoint]["web"]
+				expected := false
+
+				for _, e := range test.expected {
+					expected = expected || e == endpoint
+				}
+
+				if created != expected {
+					t.Errorf("Expected the item to be created on %v but found %v", test.expected, state)
+				}
+
+				if _, ok := d.Get(targetIdsProperty).(map[string]interface{})[endpoint]; ok != expected {
+					t.Errorf("Expected endpoint ids for %v but found %v", test.expected, d.Get(targetIdsProperty))
+				}
+			}
+
+			if diags := resource.DeleteContext(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("Unexpected error %v", diags)
+			}
+
+			if len(state["primary"])+len(state["secondary"]) != 0 {
+				t.Errorf("Expected the item to be deleted from every endpoint but found %v", state)
+			}
+		})
+	}
+}
+
+func Test_DriftIsReadFromEveryEndpoint(t *testing.T) {
+	for _, endpoint := range []string{"primary", "secondary"} {
+		t.Run(endpoint, func(t *testing.T) {
+			state := map[string]map[string]*testEndpointItem{}
+			resource := testEndpointResource(state)
+			client := testEndpointClients(targetAll)
+
+			d := resource.TestResourceData()
+			d.Set("name", "web")
+			d.Set("value", "80")
+
+			if diags := resource.CreateContext(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("Unexpected error %v", diags)
+			}
+
+			state[endpoint]["web"].Value = "8080"
+
+			d = resource.Data(d.State())
+
+			if diags := resource.ReadContext(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("Unexpected error %v", diags)
+			}
+
+			if d.Get("value") != "8080" {
+				t.Errorf("Expected the change on %s to be read but found %s", endpoint, d.Get("value"))
+			}
+		})
+	}
+}
+
+func Test_MissingEndpointIsCreatedAgain(t *testing.T) {
+	for _, endpoint := range []string{"primary", "secondary"} {
+		t.Run(endpoint, func(t *testing.T) {
+			state := map[string]map[string]*testEndpointItem{}
+			resource := testEndpointResource(state)
+			client := testEndpointClients(targetAll)
+			config := map[string]interface{}{"name": "web", "value": "80"}
+
+			d := resource.TestResourceData()
+
+			for key, value := range config {
+				d.Set(key, value)
+			}
+
+			if diags := resource.CreateContext(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("Unexpected error %v", diags)
+			}
+
+			delete(state[endpoint], "web")
+
+			d = resource.Data(d.State())
+			diags := resource.ReadContext(context.Background(), d, client)
+
+			if diags.HasError() || len(diags) != 1 || diags[0].Severity != diag.Warning {
+				t.Fatalf("Expected a warning about the missing endpoint but found %v", diags)
+			}
+
+			if _, ok := d.Get(targetIdsProperty).(map[string]interface{})[endpoint]; ok || d.Id() != "web" {
+				t.Fatalf("Expected only the ID on %s to be removed but found %s and %v", endpoint, d.Id(), d.Get(targetIdsProperty))
+			}
+
+			diff, err := resource.SimpleDiff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), client)
+
+			if err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+
+			if diff == nil || diff.RequiresNew() || !diff.Attributes[targetIdsProperty+".%"].NewComputed {
+				t.Fatalf("Expected an update creating the item on %s but found %v", endpoint, diff)
+			}
+
+			applied, diags := resource.Apply(context.Background(), d.State(), diff, client)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected error %v", diags)
+			}
+
+			if state[endpoint]["web"] == nil || applied.Attributes[targetIdsProperty+"."+endpoint] != "web" || applied.ID != "web" {
+				t.Errorf("Expected the item to be created on %s but found %v and %v", endpoint, state, applied)
+			}
+		})
+	}
+}
+
+func Test_UnknownTarget(t *testing.T) {
+	client := testEndpointClients("primary")
+
+	if _, err := client.targets("tertiary"); err == nil {
+		t.Errorf("Expected an error for an unknown endpoint")
+	}
+
+	if _, err := newAPIClients(pfsenseapi.Config{}, []apiEndpoint{{name: "primary", url: "https://a.test"}}, "secondary", apiClientSettings{maxConcurrentWrites: 1}); err == nil {
+		t.Errorf("Expected an error for an unknown default target")
+	}
+
+	if _, err := newAPIClients(pfsenseapi.Config{}, []apiEndpoint{{name: "primary", url: "https://a.test"}, {name: "primary", url: "https://b.test"}}, "", apiClientSettings{maxConcurrentWrites: 1}); err == nil {
+		t.Errorf("Expected an error for endpoints with the same name")
+	}
+
+}
@@ -0,0 +1,117 @@
+package pfsense
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+const (
+	tlsModeVerify = "verify"
+	tlsModeSkip   = "skip"
+	tlsModePin    = "pin"
+)
+
+var tlsModes = []string{tlsModeVerify, tlsModeSkip, tlsModePin}
+
+// tlsSettings are the provider settings for connecting to pfSense, or a reverse proxy in front of it, over HTTPS.
+type tlsSettings struct {
+	mode         string
+	fingerprints []string
+	caCertPEM    string
+	caCertFile   string
+	clientCert   string
+	clientKey    string
+	serverName   string
+}
+
+// newTLSConfig creates the TLS configuration for the mode. verify checks the certificate chain and name against the
+// CA certificates, skip accepts any certificate and pin only accepts certificates with one of the fingerprints.
+func newTLSConfig(settings tlsSettings) (*tls.Config, error) {
+	config := &tls.Config{
+		ServerName: settings.serverName,
+	}
+
+	switch settings.mode {
+	case tlsModeVerify, "":
+		if len(settings.fingerprints) > 0 {
+			return nil, fmt.Errorf("Unable to use certificate fingerprints unless tls_mode is %s", tlsModePin)
+		}
+	case tlsModeSkip:
+		config.InsecureSkipVerify = true
+	case tlsModePin:
+		if len(settings.fingerprints) == 0 {
+			return nil, fmt.Errorf("At least one certificate fingerprint is required when tls_mode is %s", tlsModePin)
+		}
+
+		fingerprints := make([]string, len(settings.fingerprints))
+
+		for i, fingerprint := range settings.fingerprints {
+			fingerprints[i] = normalizeFingerprint(fingerprint)
+		}
+
+		// the chain isn't verified, the certificate is trusted because it's the one that was pinned
+		config.InsecureSkipVerify = true
+		config.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return fmt.Errorf("No certificate was presented by pfSense")
+			}
+
+			fingerprint := certificateFingerprint(rawCerts[0])
+
+			if !slices.Contains(fingerprints, fingerprint) {
+				return fmt.Errorf("Certificate fingerprint %s isn't one of the pinned fingerprints", fingerprint)
+			}
+
+			return nil
+		}
+	default:
+		return nil, fmt.Errorf("Unknown tls_mode %s", settings.mode)
+	}
+
+	caCertPEM := []byte(settings.caCertPEM)
+
+	if settings.caCertFile != "" {
+		var err error
+
+		if caCertPEM, err = os.ReadFile(settings.caCertFile); err != nil {
+			return nil, fmt.Errorf("Unable to read ca_cert_file %s: %v", settings.caCertFile, err)
+		}
+	}
+
+	if len(caCertPEM) > 0 {
+		config.RootCAs = x509.NewCertPool()
+
+		if !config.RootCAs.AppendCertsFromPEM(caCertPEM) {
+			return nil, fmt.Errorf("Unable to find a PEM encoded certificate in the CA certificates")
+		}
+	}
+
+	if settings.clientCert != "" || settings.clientKey != "" {
+		certificate, err := tls.X509KeyPair([]byte(settings.clientCert), []byte(settings.clientKey))
+
+		if err != nil {
+			return nil, fmt.Errorf("Unable to load the client certificate: %v", err)
+		}
+
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
+
+// certificateFingerprint is the SHA-256 fingerprint of a DER encoded certificate as lower case hex.
+func certificateFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeFingerprint accepts fingerprints in the format shown by browsers and openssl, e.g. AB:CD:...
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(fingerprint), ":", ""))
+}
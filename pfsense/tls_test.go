@@ -0,0 +1,262 @@
+package pfsense
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+func testTLSHandler() http.Handler {
+	return testVersionHandler("v1.7.6")
+}
+
+func testCertificatePEM(certificate *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw}))
+}
+
+// testClientCertificate creates a self signed client certificate and returns it and its key PEM encoded.
+func testClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatalf("Unable to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+
+	if err != nil {
+		t.Fatalf("Unable to create certificate: %v", err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+
+	if err != nil {
+		t.Fatalf("Unable to parse certificate: %v", err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+
+	if err != nil {
+		t.Fatalf("Unable to marshal key: %v", err)
+	}
+
+	return certificate, testCertificatePEM(certificate), string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func testTLSRequest(t *testing.T, url string, settings tlsSettings) error {
+	client, err := newAPIClient(pfsenseapi.Config{Host: url}, apiClientSettings{maxConcurrentWrites: 1, retry: retryPolicy{maxAttempts: 1}, tls: settings})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = client.System.GetVersion(context.Background())
+
+	return err
+}
+
+func Test_CustomCACertificate(t *testing.T) {
+	server := httptest.NewTLSServer(testTLSHandler())
+	defer server.Close()
+
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+
+	if err := os.WriteFile(caCertFile, []byte(testCertificatePEM(server.Certificate())), 0600); err != nil {
+		t.Fatalf("Unable to write CA certificate: %v", err)
+	}
+
+	tests := map[string]struct {
+		settings tlsSettings
+		valid    bool
+	}{
+		"systemCAs":         {settings: tlsSettings{}},
+		"caCertPEM":         {settings: tlsSettings{caCertPEM: testCertificatePEM(server.Certificate())}, valid: true},
+		"caCertFile":        {settings: tlsSettings{caCertFile: caCertFile}, valid: true},
+		"serverName":        {settings: tlsSettings{caCertFile: caCertFile, serverName: "example.com"}, valid: true},
+		"invalidServerName": {settings: tlsSettings{caCertFile: caCertFile, serverName: "pfsense.test"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := testTLSRequest(t, server.URL, test.settings)
+
+			if test.valid && err != nil {
+				t.Errorf("Unexpected error %v", err)
+			} else if !test.valid && err == nil {
+				t.Errorf("Expected the certificate to be rejected")
+			}
+		})
+	}
+}
+
+func Test_ClientCertificate(t *testing.T) {
+	certificate, certPEM, keyPEM := testClientCertificate(t)
+	_, otherCertPEM, otherKeyPEM := testClientCertificate(t)
+
+	server := httptest.NewUnstartedServer(testTLSHandler())
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: x509.NewCertPool()}
+	server.TLS.ClientCAs.AddCert(certificate)
+	server.StartTLS()
+	defer server.Close()
+
+	caCertPEM := testCertificatePEM(server.Certificate())
+
+	tests := map[string]struct {
+		settings tlsSettings
+		valid    bool
+	}{
+		"noCertificate":      {settings: tlsSettings{caCertPEM: caCertPEM}},
+		"trustedCertificate": {settings: tlsSettings{caCertPEM: caCertPEM, clientCert: certPEM, clientKey: keyPEM}, valid: true},
+		"unknownCertificate": {settings: tlsSettings{caCertPEM: caCertPEM, clientCert: otherCertPEM, clientKey: otherKeyPEM}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := testTLSRequest(t, server.URL, test.settings)
+
+			if test.valid && err != nil {
+				t.Errorf("Unexpected error %v", err)
+			} else if !test.valid && err == nil {
+				t.Errorf("Expected the connection to be rejected")
+			}
+		})
+	}
+}
+
+func Test_TLSModes(t *testing.T) {
+	server := httptest.NewTLSServer(testTLSHandler())
+	defer server.Close()
+
+	fingerprint := certificateFingerprint(server.Certificate().Raw)
+	colonFingerprint := make([]string, 0, len(fingerprint)/2)
+
+	for i := 0; i < len(fingerprint); i += 2 {
+		colonFingerprint = append(colonFingerprint, strings.ToUpper(fingerprint[i:i+2]))
+	}
+
+	// every httptest server has the same certificate so another one is generated
+	otherCertificate, otherCertPEM, _ := testClientCertificate(t)
+
+	tests := map[string]struct {
+		settings tlsSettings
+		valid    bool
+	}{
+		"verifyUntrusted":  {settings: tlsSettings{mode: tlsModeVerify}},
+		"verifyTrusted":    {settings: tlsSettings{mode: tlsModeVerify, caCertPEM: testCertificatePEM(server.Certificate())}, valid: true},
+		"defaultIsVerify":  {settings: tlsSettings{}},
+		"skip":             {settings: tlsSettings{mode: tlsModeSkip}, valid: true},
+		"pinMatches":       {settings: tlsSettings{mode: tlsModePin, fingerprints: []string{fingerprint}}, valid: true},
+		"pinWithColons":    {settings: tlsSettings{mode: tlsModePin, fingerprints: []string{strings.Join(colonFingerprint, ":")}}, valid: true},
+		"pinOneOfMany":     {settings: tlsSettings{mode: tlsModePin, fingerprints: []string{strings.Repeat("ab", 32), fingerprint}}, valid: true},
+		"pinDoesNotMatch":  {settings: tlsSettings{mode: tlsModePin, fingerprints: []string{certificateFingerprint(otherCertificate.Raw)}}},
+		"pinIgnoresCAs":    {settings: tlsSettings{mode: tlsModePin, fingerprints: []string{strings.Repeat("ab", 32)}, caCertPEM: testCertificatePEM(server.Certificate())}},
+		"pinIgnoresName":   {settings: tlsSettings{mode: tlsModePin, fingerprints: []string{fingerprint}, serverName: "pfsense.test"}, valid: true},
+		"verifyChecksName": {settings: tlsSettings{mode: tlsModeVerify, caCertPEM: testCertificatePEM(server.Certificate()), serverName: "pfsense.test"}},
+		"verifyOtherCA":    {settings: tlsSettings{mode: tlsModeVerify, caCertPEM: otherCertPEM}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := testTLSRequest(t, server.URL, test.settings)
+
+			if test.valid && err != nil {
+				t.Errorf("Unexpected error %v", err)
+			} else if !test.valid && err == nil {
+				t.Errorf("Expected the certificate to be rejected")
+			}
+		})
+	}
+}
+
+// Test_ProviderTLSMode configures the provider the way users do, https urls used to skip verification by default.
+func Test_ProviderTLSMode(t *testing.T) {
+	server := httptest.NewTLSServer(testTLSHandler())
+	defer server.Close()
+
+	tests := map[string]struct {
+		config map[string]interface{}
+		valid  bool
+	}{
+		"default":            {config: map[string]interface{}{}},
+		"verify":             {config: map[string]interface{}{"tls_mode": tlsModeVerify}},
+		"skip":               {config: map[string]interface{}{"tls_mode": tlsModeSkip}, valid: true},
+		"allowInsecure":      {config: map[string]interface{}{"allow_insecure": true}, valid: true},
+		"allowInsecureFalse": {config: map[string]interface{}{"allow_insecure": false}},
+		"pin": {
+			config: map[string]interface{}{"tls_mode": tlsModePin, "tls_fingerprints": []interface{}{certificateFingerprint(server.Certificate().Raw)}},
+			valid:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+			test.config["url"] = server.URL
+			test.config["retry"] = []interface{}{map[string]interface{}{"max_attempts": 1}}
+
+			d := schema.TestResourceDataRaw(t, Provider().Schema, test.config)
+			m, diags := providerConfigure(context.Background(), d)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected error %v", diags)
+			}
+
+			_, err := m.(*apiClient).System.GetVersion(context.Background())
+
+			if test.valid && err != nil {
+				t.Errorf("Unexpected error %v", err)
+			} else if !test.valid && err == nil {
+				t.Errorf("Expected the certificate to be rejected")
+			}
+		})
+	}
+}
+
+func Test_InvalidTLSSettings(t *testing.T) {
+	_, certPEM, _ := testClientCertificate(t)
+
+	tests := map[string]tlsSettings{
+		"invalidCACert":    {caCertPEM: "not a certificate"},
+		"missingCACert":    {caCertFile: filepath.Join(t.TempDir(), "missing.pem")},
+		"mismatchedKey":    {clientCert: certPEM, clientKey: "not a key"},
+		"missingClientKey": {clientCert: certPEM},
+		"unknownMode":      {mode: "trust"},
+		"pinWithoutPins":   {mode: tlsModePin},
+		"verifyWithPins":   {mode: tlsModeVerify, fingerprints: []string{strings.Repeat("ab", 32)}},
+	}
+
+	for name, settings := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := newTLSConfig(settings); err == nil {
+				t.Errorf("Expected an error")
+			}
+		})
+	}
+}
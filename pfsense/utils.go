@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
@@ -95,7 +96,7 @@ func interfaceToStringArray(value interface{}) ([]string, error) {
 	output, ok := value.([]interface{})
 
 	if !ok {
-		return nil, fmt.Errorf("Value is %v not a []interface{}", output)
+		return nil, fmt.Errorf("Value is %v not a []interface{}", value)
 	}
 
 	result := make([]string, len(output))
@@ -104,7 +105,7 @@ func interfaceToStringArray(value interface{}) ([]string, error) {
 		result[i], ok = item.(string)
 
 		if !ok {
-			return nil, fmt.Errorf("Value %v contains non string values", output)
+			return nil, fmt.Errorf("Value %v contains non string values", value)
 		}
 	}
 
@@ -120,3 +121,54 @@ func regexValidator(regularExpression string) *regexp.Regexp {
 
 	return r
 }
+
+func interfaceToIntArray(value interface{}) ([]int, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	output, ok := value.([]interface{})
+
+	if !ok {
+		return nil, fmt.Errorf("Value is %v not a []interface{}", value)
+	}
+
+	result := make([]int, len(output))
+
+	for i, item := range output {
+		result[i], ok = item.(int)
+
+		if !ok {
+			return nil, fmt.Errorf("Value %v contains non int values", value)
+		}
+	}
+
+	return result, nil
+}
+
+func joinInts(values []int, separator string) string {
+	result := make([]string, len(values))
+
+	for i, value := range values {
+		result[i] = strconv.Itoa(value)
+	}
+
+	return strings.Join(result, separator)
+}
+
+func splitIntoIntArray(value string, separator string) ([]int, error) {
+	parts := splitIntoArray(value, separator)
+	result := make([]int, len(parts))
+
+	for i, part := range parts {
+		number, err := strconv.Atoi(strings.TrimSpace(part))
+
+		if err != nil {
+			return nil, fmt.Errorf("Unable to parse %s as a list of numbers: %v", value, err)
+		}
+
+		result[i] = number
+	}
+
+	return result, nil
+}
@@ -0,0 +1,170 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+// The provider is written against version 1 of the pfSense API, version 2 replaced its endpoints.
+const (
+	minimumAPIVersion     = "1.0.0"
+	unsupportedAPIVersion = "2.0.0"
+)
+
+// pfsenseVersion is what pfSense and the API package installed on it report about their versions.
+type pfsenseVersion struct {
+	System *pfsenseapi.Version
+	API    *pfsenseapi.APIVersion
+}
+
+// version queries the versions the first time they are needed and keeps them for the rest of the run.
+func (c *apiClient) version(ctx context.Context) (*pfsenseVersion, error) {
+	c.versionLock.Lock()
+	defer c.versionLock.Unlock()
+
+	if c.cachedVersion != nil {
+		return c.cachedVersion, nil
+	}
+
+	version := new(pfsenseVersion)
+	var err error
+
+	if version.System, err = c.System.GetVersion(ctx); err != nil {
+		return nil, fmt.Errorf("Unable to query the pfSense version: %v", err)
+	}
+
+	if version.API, err = c.System.GetAPIVersion(ctx); err != nil {
+		return nil, fmt.Errorf("Unable to query the pfSense API version: %v", err)
+	}
+
+	if version.System == nil || version.API == nil {
+		return nil, fmt.Errorf("Unable to query the pfSense version, no version was returned by %s", c.Cfg.Host)
+	}
+
+	c.cachedVersion = version
+
+	return version, nil
+}
+
+// requireAPIVersion returns an error naming what needs the minimum version if the API installed is older.
+func (c *apiClient) requireAPIVersion(ctx context.Context, minimum string, feature string) error {
+	version, err := c.version(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	compared, err := compareVersions(version.API.CurrentVersion, minimum)
+
+	if err != nil {
+		return err
+	}
+
+	if compared < 0 {
+		return fmt.Errorf("%s requires version %s of the pfSense API but %s has version %s installed", feature, minimum, c.Cfg.Host, version.API.CurrentVersion)
+	}
+
+	return nil
+}
+
+// checkAPIVersions queries the version of every endpoint when the provider is configured, so that a firewall running
+// an API the provider doesn't support fails before anything is planned. Endpoints whose version can't be queried, e.g.
+// because the user isn't allowed to read it, only get a warning. The versions are kept for the rest of the run.
+func (c *apiClient) checkAPIVersions(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, endpoint := range c.endpoints {
+		version, err := endpoint.version(ctx)
+		var minimum, unsupported int
+
+		if err == nil {
+			minimum, err = compareVersions(version.API.CurrentVersion, minimumAPIVersion)
+		}
+
+		if err == nil {
+			unsupported, err = compareVersions(version.API.CurrentVersion, unsupportedAPIVersion)
+		}
+
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Unable to check the pfSense API version of endpoint %s", endpoint.name),
+				Detail:   fmt.Sprintf("%v. The provider supports versions from %s and before %s of the pfSense API.", err, minimumAPIVersion, unsupportedAPIVersion),
+			})
+
+			continue
+		}
+
+		if minimum < 0 || unsupported >= 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Endpoint %s has an unsupported version of the pfSense API", endpoint.name),
+				Detail:   fmt.Sprintf("%s has version %s of the pfSense API installed but the provider supports versions from %s and before %s.", endpoint.Cfg.Host, version.API.CurrentVersion, minimumAPIVersion, unsupportedAPIVersion),
+			})
+		}
+	}
+
+	return diags
+}
+
+// parseVersion parses versions such as v1.7.6 and 2.7.0-RELEASE into their numbers.
+func parseVersion(version string) ([]int, error) {
+	trimmed := strings.TrimPrefix(strings.TrimSpace(version), "v")
+	trimmed, _, _ = strings.Cut(trimmed, "-")
+	parts := strings.Split(trimmed, ".")
+	numbers := make([]int, len(parts))
+
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+
+		if err != nil {
+			return nil, fmt.Errorf("Unable to parse version %s", version)
+		}
+
+		numbers[i] = number
+	}
+
+	return numbers, nil
+}
+
+// compareVersions returns -1, 0 or 1 when a is older, the same as or newer than b, missing numbers count as 0.
+func compareVersions(a string, b string) (int, error) {
+	aNumbers, err := parseVersion(a)
+
+	if err != nil {
+		return 0, err
+	}
+
+	bNumbers, err := parseVersion(b)
+
+	if err != nil {
+		return 0, err
+	}
+
+	for i := 0; i < max(len(aNumbers), len(bNumbers)); i++ {
+		var aNumber, bNumber int
+
+		if i < len(aNumbers) {
+			aNumber = aNumbers[i]
+		}
+
+		if i < len(bNumbers) {
+			bNumber = bNumbers[i]
+		}
+
+		if aNumber != bNumber {
+			if aNumber < bNumber {
+				return -1, nil
+			}
+
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
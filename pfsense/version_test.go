@@ -0,0 +1,193 @@
+package pfsense
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/sjafferali/pfsense-api-goclient/pfsenseapi"
+)
+
+// testVersionHandler serves the versions of pfSense 2.7.0 with apiVersion of the API installed.
+func testVersionHandler(apiVersion string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/system/version":
+			fmt.Fprint(w, `{"status": "ok", "code": 200, "return": 0, "message": "Success", "data": {"version": "2.7.0-RELEASE", "base": "2.7.0", "patch": "0", "buildtime": "Wed Jun 28 03:53:34 UTC 2023"}}`)
+		case "/api/v1/system/api/version":
+			fmt.Fprintf(w, `{"status": "ok", "code": 200, "return": 0, "message": "Success", "data": {"current_version": "%s", "latest_version": "v1.7.6", "update_available": true}}`, apiVersion)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func testVersionServer(t *testing.T, apiVersion string) *apiClient {
+	server := httptest.NewServer(testVersionHandler(apiVersion))
+	t.Cleanup(server.Close)
+
+	return testAPIClient(t, pfsenseapi.Config{Host: server.URL}, apiClientSettings{})
+}
+
+func Test_CompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"v1.3.0", "1.3.0", 0},
+		{"v1.2.9", "1.3.0", -1},
+		{"v1.10.0", "1.3.0", 1},
+		{"2.7.0-RELEASE", "2.7", 0},
+		{"v1.3", "1.3.1", -1},
+	}
+
+	for _, test := range tests {
+		compared, err := compareVersions(test.a, test.b)
+
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		if compared != test.expected {
+			t.Errorf("Expected comparing %s to %s to be %d but found %d", test.a, test.b, test.expected, compared)
+		}
+	}
+
+	if _, err := compareVersions("latest", "1.3.0"); err == nil {
+		t.Errorf("Expected an error for an invalid version")
+	}
+}
+
+func Test_MinimumVersionIsCheckedWhenPlanning(t *testing.T) {
+	tests := map[string]struct {
+		apiVersion       string
+		resourceMinimum  string
+		attributeMinimum string
+		config           map[string]interface{}
+		err              string
+	}{
+		"resourceSupported":   {apiVersion: "v1.3.0", resourceMinimum: "1.3.0", config: map[string]interface{}{"interface": "lan", "name": "printer"}},
+		"resourceUnsupported": {apiVersion: "v1.2.0", resourceMinimum: "1.3.0", config: map[string]interface{}{"interface": "lan", "name": "printer"}, err: "pfsense_test_import_item requires version 1.3.0"},
+		"attributeUnused":     {apiVersion: "v1.2.0", attributeMinimum: "1.3.0", config: map[string]interface{}{"interface": "lan", "name": "printer"}},
+		"attributeUsed":       {apiVersion: "v1.2.0", attributeMinimum: "1.3.0", config: map[string]interface{}{"interface": "lan", "name": "printer", "description": "Printer"}, err: "description on pfsense_test_import_item requires version 1.3.0"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := testImportResource(nil)
+			r.minimumVersion = test.resourceMinimum
+			r.properties["description"].minimumVersion = test.attributeMinimum
+
+			client := testVersionServer(t, test.apiVersion)
+			_, err := r.schemaResource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(test.config), client)
+
+			if test.err == "" && err != nil {
+				t.Errorf("Unexpected error %v", err)
+			} else if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+				t.Errorf("Expected an error containing %s but found %v", test.err, err)
+			}
+		})
+	}
+}
+
+func Test_APIVersionIsCheckedWhenConfiguring(t *testing.T) {
+	tests := map[string]struct {
+		apiVersions []string
+		err         string
+		warning     string
+	}{
+		"supported":      {apiVersions: []string{"v1.7.6", "v1.0.0"}},
+		"tooOld":         {apiVersions: []string{"v1.7.6", "v0.9.0"}, err: "has version v0.9.0 of the pfSense API installed"},
+		"tooNew":         {apiVersions: []string{"v2.0.0", "v1.7.6"}, err: "has version v2.0.0 of the pfSense API installed"},
+		"invalidVersion": {apiVersions: []string{"v1.7.6", "latest"}, warning: "Unable to check the pfSense API version of endpoint secondary"},
+		"forbidden":      {apiVersions: []string{"", "v1.7.6"}, warning: "Unable to check the pfSense API version of endpoint primary"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+
+			var endpoints []interface{}
+			requests := map[string]int{}
+
+			for i, apiVersion := range test.apiVersions {
+				endpointName := []string{"primary", "secondary"}[i]
+				forbidden := apiVersion == ""
+				handler := testVersionHandler(apiVersion)
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requests[endpointName+r.URL.Path]++
+
+					// an empty version stands for a user that isn't allowed to read it
+					if forbidden {
+						w.WriteHeader(http.StatusForbidden)
+						return
+					}
+
+					handler.ServeHTTP(w, r)
+				}))
+				t.Cleanup(server.Close)
+
+				endpoints = append(endpoints, map[string]interface{}{"name": endpointName, "url": server.URL})
+			}
+
+			config := map[string]interface{}{"endpoint": endpoints, "retry": []interface{}{map[string]interface{}{"max_attempts": 1}}}
+			d := schema.TestResourceDataRaw(t, Provider().Schema, config)
+			m, diags := providerConfigure(context.Background(), d)
+
+			if test.err != "" {
+				if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), test.err) {
+					t.Errorf("Expected an error containing %s but found %v", test.err, diags)
+				}
+
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected error %v", diags)
+			}
+
+			if test.warning != "" && (len(diags) != 1 || diags[0].Severity != diag.Warning || diags[0].Summary != test.warning) {
+				t.Errorf("Expected the warning %s but found %v", test.warning, diags)
+			} else if test.warning == "" && len(diags) != 0 {
+				t.Errorf("Unexpected warnings %v", diags)
+			}
+
+			if test.warning != "" {
+				return
+			}
+
+			// the versions queried when configuring are used when planning
+			for _, client := range m.(*apiClient).endpoints {
+				if err := client.requireAPIVersion(context.Background(), "1.0.0", "test"); err != nil {
+					t.Errorf("Unexpected error %v", err)
+				}
+			}
+
+			for _, endpointName := range []string{"primary", "secondary"} {
+				if count := requests[endpointName+"/api/v1/system/api/version"]; count != 1 {
+					t.Errorf("Expected the API version of %s to be queried once but it was queried %d times", endpointName, count)
+				}
+			}
+		})
+	}
+}
+
+func Test_SystemVersionDataSource(t *testing.T) {
+	client := testVersionServer(t, "v1.7.6")
+	dataSource := dataSourceSystemVersion()
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{})
+
+	if diags := dataSource.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("Unexpected error %v", diags)
+	}
+
+	if d.Get("version") != "2.7.0-RELEASE" || d.Get("api_version") != "v1.7.6" || d.Get("api_update_available") != true {
+		t.Errorf("Unexpected versions %s and %s", d.Get("version"), d.Get("api_version"))
+	}
+}
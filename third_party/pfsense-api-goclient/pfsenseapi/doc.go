@@ -0,0 +1,2 @@
+// Package pfsenseapi provides the api client for calling the pfsense api
+package pfsenseapi